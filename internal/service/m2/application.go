@@ -5,32 +5,53 @@ package m2
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/m2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
-	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -41,7 +62,7 @@ import (
 func newApplicationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &applicationResource{}
 
-	r.SetDefaultCreateTimeout(30 * time.Minute)
+	// The default Create timeout depends on the engine type, see applicationCreateTimeout.
 	r.SetDefaultUpdateTimeout(30 * time.Minute)
 	r.SetDefaultDeleteTimeout(30 * time.Minute)
 
@@ -50,7 +71,6 @@ func newApplicationResource(context.Context) (resource.ResourceWithConfigure, er
 
 type applicationResource struct {
 	framework.ResourceWithConfigure
-	framework.WithImportByID
 	framework.WithTimeouts
 }
 
@@ -61,33 +81,90 @@ func (*applicationResource) Metadata(_ context.Context, request resource.Metadat
 func (r *applicationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			names.AttrApplicationID: framework.IDAttribute(),
-			names.AttrARN:           framework.ARNAttributeComputedOnly(),
+			"allow_cross_account": schema.BoolAttribute{
+				Description: "Whether to allow `role_arn` to be in a different account than the provider.",
+				Optional:    true,
+			},
+			names.AttrApplicationID: schema.StringAttribute{
+				Description: "Identifier of the application.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreationTime: schema.StringAttribute{
+				Description: "Time the application was created, in RFC3339 format.",
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"current_version": schema.Int64Attribute{
-				Computed: true,
+				Description: "Version of the application whose definition is read. The version recorded in state is kept while it exists, otherwise the latest version is used, e.g. on import.",
+				Computed:    true,
+			},
+			"deployed_environment_ids": schema.ListAttribute{
+				Description: "IDs of the environments the application is deployed to. Failed deployments are not included.",
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether to prevent Terraform from deleting the application. Must be set to `false` and applied before the application can be destroyed.",
+				Optional:    true,
 			},
 			names.AttrDescription: schema.StringAttribute{
-				Optional: true,
+				Description: "Description of the application. At most 500 characters. Changing only the description doesn't change the application definition.",
+				Optional:    true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(500),
 				},
 			},
 			"engine_type": schema.StringAttribute{
-				CustomType: fwtypes.StringEnumType[awstypes.EngineType](),
-				Required:   true,
+				Description: "Engine type of the application, `bluage` or `microfocus`. Changing this forces a new resource to be created.",
+				CustomType:  fwtypes.StringEnumType[awstypes.EngineType](),
+				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"environment_id": schema.StringAttribute{
+				Description: "ID of the environment the application is deployed to. Only set when the application is deployed to exactly one environment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrID: framework.IDAttribute(),
 			names.AttrKMSKeyID: schema.StringAttribute{
-				Optional: true,
+				Description: "KMS key used to encrypt the application. Changing this forces a new resource to be created.",
+				Optional:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"log_groups": schema.ListAttribute{
+				Description: "CloudWatch log groups that Mainframe Modernization sends the application's logs to. Only known once the application has been deployed.",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[logGroupSummaryModel](ctx),
+				Computed:    true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						names.AttrLogGroupName: types.StringType,
+						"log_type":             types.StringType,
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrName: schema.StringAttribute{
-				Required: true,
+				Description: "Name of the application. Must be unique within the account and Region. Changing this forces a new resource to be created.",
+				Required:    true,
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]{1,59}$`), ""),
 				},
@@ -96,36 +173,162 @@ func (r *applicationResource) Schema(ctx context.Context, request resource.Schem
 				},
 			},
 			names.AttrRoleARN: schema.StringAttribute{
-				CustomType: fwtypes.ARNType,
-				Optional:   true,
+				Description: "ARN of the IAM role the application uses to access AWS resources. Must be in the provider's partition. Changing this forces a new resource to be created.",
+				CustomType:  fwtypes.ARNType,
+				Optional:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"skip_name_uniqueness_check": schema.BoolAttribute{
+				Description: "Whether to skip checking, before creating the application, that no application with the same name exists.",
+				Optional:    true,
+			},
+			"status_reason": schema.StringAttribute{
+				Description: "Reason for the application's status, such as why it failed. Refreshed on every read.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stop_before_delete": schema.BoolAttribute{
+				Description: "Whether to stop the application, if it's starting or running, before deleting it. The application can't be deleted while it's running. Defaults to `true`.",
+				Optional:    true,
+			},
+			"trigger_version_replacement": schema.MapAttribute{
+				Description: "Arbitrary values that, when changed, create a new application version from the current definition.",
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.AlsoRequires(path.MatchRoot("definition")),
+				},
+			},
+			"validate_definition_s3_location": schema.BoolAttribute{
+				Description: "Whether to check, when planning the creation of the application, that the definition S3 object exists.",
+				Optional:    true,
+			},
+			"validate_role_trust_policy": schema.BoolAttribute{
+				Description: "Whether to check, when planning the creation of the application, that the role's trust policy allows Mainframe Modernization to assume it.",
+				Optional:    true,
+			},
+			"versions": schema.ListAttribute{
+				Description: "Versions of the application.",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[applicationVersionSummaryModel](ctx),
+				Computed:    true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"application_version":  types.Int64Type,
+						names.AttrCreationTime: timetypes.RFC3339Type{},
+						names.AttrStatus:       fwtypes.StringEnumType[awstypes.ApplicationVersionLifecycle](),
+					},
+				},
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description: "Whether to wait, when creating the application, for it to become available. Defaults to `true`.",
+				Optional:    true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"definition": schema.ListNestedBlock{
-				CustomType: fwtypes.NewListNestedObjectTypeOf[definitionModel](ctx),
+				Description: "Application definition. Exactly one of `content`, `content_base64`, `content_file`, `s3_location` or `s3_bucket` must be set. Changing the definition creates a new application version.",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[definitionModel](ctx),
 				Validators: []validator.List{
-					listvalidator.IsRequired(),
-					listvalidator.SizeAtMost(1),
+					definitionBlockCountValidator(),
+				},
+				PlanModifiers: []planmodifier.List{
+					definitionUseStateWhenUnchanged(),
 				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						names.AttrContent: schema.StringAttribute{
-							Optional: true,
+							Description: "JSON application definition. At most 65000 bytes, unless `staging_bucket` is set.",
+							Optional:    true,
 							Validators: []validator.String{
-								stringvalidator.LengthBetween(1, 65000),
+								stringvalidator.LengthAtLeast(1),
+								definitionContentMaxLengthValidator(),
+								definitionContentSizeWarningValidator(),
 								stringvalidator.ExactlyOneOf(
 									path.MatchRelative().AtParent().AtName(names.AttrContent),
+									path.MatchRelative().AtParent().AtName("content_base64"),
+									path.MatchRelative().AtParent().AtName("content_file"),
+									path.MatchRelative().AtParent().AtName("s3_bucket"),
 									path.MatchRelative().AtParent().AtName("s3_location"),
 								),
 							},
 						},
+						"content_base64": schema.StringAttribute{
+							Description: "Base64-encoded JSON application definition. At most 65000 bytes once decoded.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"content_file": schema.StringAttribute{
+							Description: "Path to a local file containing the JSON application definition. Only the file's hash is stored in state.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"content_file_hash": schema.StringAttribute{
+							Description: "SHA-256 hash of the `content_file` contents.",
+							Computed:    true,
+						},
+						"normalized_s3_location": schema.StringAttribute{
+							Description: "S3 location sent to the API, with any `s3_object_version` pinned in the URI.",
+							Computed:    true,
+						},
+						"s3_bucket": schema.StringAttribute{
+							Description: "Name of the S3 bucket containing the application definition. Requires `s3_key`. An alternative to `s3_location`.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z][0-9a-z.-]{1,61}[0-9a-z]$`), "must be an S3 bucket name, not an S3 URI"),
+								stringvalidator.AlsoRequires(
+									path.MatchRelative().AtParent().AtName("s3_key"),
+								),
+							},
+						},
+						"s3_key": schema.StringAttribute{
+							Description: "Key of the S3 object containing the application definition. Requires `s3_bucket`.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+								stringvalidator.AlsoRequires(
+									path.MatchRelative().AtParent().AtName("s3_bucket"),
+								),
+							},
+						},
 						"s3_location": schema.StringAttribute{
-							Optional: true,
+							Description: "S3 URI of the application definition, of the form `s3://bucket/key`.",
+							Optional:    true,
+							Validators: []validator.String{
+								definitionS3LocationValidator(),
+							},
+						},
+						"staged_s3_location": schema.StringAttribute{
+							Description: "S3 location that oversized `content` is staged to in `staging_bucket`.",
+							Computed:    true,
+						},
+						"staging_bucket": schema.StringAttribute{
+							Description: "Name of an S3 bucket to stage `content` larger than 65000 bytes to. Requires `content`.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+								stringvalidator.AlsoRequires(
+									path.MatchRelative().AtParent().AtName(names.AttrContent),
+								),
+							},
+						},
+						"s3_object_version": schema.StringAttribute{
+							Description: "Version ID of the S3 object at `s3_location`, or `s3_bucket` and `s3_key`, to use.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+								definitionS3ObjectVersionValidator(),
+							},
 						},
 					},
 				},
@@ -155,7 +358,16 @@ func (r *applicationResource) Create(ctx context.Context, request resource.Creat
 		return
 	}
 
+	if !data.SkipNameUniquenessCheck.ValueBool() {
+		if err := checkApplicationNameAvailable(ctx, conn, name); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating Mainframe Modernization Application (%s)", name), err.Error())
+
+			return
+		}
+	}
+
 	// AutoFlEx doesn't yet handle union types.
+	var stagedS3Location types.String
 	if !data.Definition.IsNull() {
 		definitionData, diags := data.Definition.ToPtr(ctx)
 		response.Diagnostics.Append(diags...)
@@ -163,31 +375,58 @@ func (r *applicationResource) Create(ctx context.Context, request resource.Creat
 			return
 		}
 
-		input.Definition = expandDefinition(definitionData)
+		// The staged S3 location may not have been known at plan time.
+		definitionData.StagedS3Location = flattenDefinitionStagedS3Location(name, definitionData)
+		if !definitionData.StagedS3Location.IsNull() {
+			if err := stageDefinitionContent(ctx, r.Meta().S3Client(ctx), definitionData); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("creating Mainframe Modernization Application (%s)", name), err.Error())
+
+				return
+			}
+		}
+		stagedS3Location = definitionData.StagedS3Location
+		data.Definition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, definitionData)
+
+		definition, err := expandDefinition(definitionData)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating Mainframe Modernization Application (%s)", name), err.Error())
+
+			return
+		}
+
+		input.Definition = definition
 	}
 
 	// Additional fields.
 	input.ClientToken = aws.String(sdkid.UniqueId())
 	input.Tags = getTagsIn(ctx)
 
-	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.AccessDeniedException](ctx, propagationTimeout, func() (interface{}, error) {
+	output, err := retryWhenRoleNotPropagated(ctx, propagationTimeout, func() (*m2.CreateApplicationOutput, error) {
 		return conn.CreateApplication(ctx, input)
-	}, "does not have proper Trust Policy for M2 service")
+	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating Mainframe Modernization Application (%s)", name), err.Error())
+		definitionData, _ := data.Definition.ToPtr(ctx)
+		response.Diagnostics.Append(applicationErrorDiagnostic(fmt.Sprintf("creating Mainframe Modernization Application (%s)", name), err, definitionData))
+
+		if !stagedS3Location.IsNull() {
+			if err := deleteStagedDefinitionContent(ctx, r.Meta().S3Client(ctx), stagedS3Location.ValueString()); err != nil {
+				response.Diagnostics.AddWarning("Staged Definition Content Not Deleted", err.Error())
+			}
+		}
 
 		return
 	}
 
 	// Set values for unknowns.
-	data.ApplicationID = fwflex.StringToFramework(ctx, outputRaw.(*m2.CreateApplicationOutput).ApplicationId)
+	data.ApplicationID = fwflex.StringToFramework(ctx, output.ApplicationId)
 	data.setID()
 
-	app, err := waitApplicationCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+	app, err := waitApplicationCreatedIfReady(ctx, conn, data.ID.ValueString(), data.WaitForReady.IsNull() || data.WaitForReady.ValueBool(), applicationCreateTimeout(ctx, data.Timeouts, data.EngineType.ValueEnum()))
 
 	if err != nil {
-		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.Append(setApplicationCreateOutputState(ctx, &response.State, output)...) // Set identifiers so as to taint the resource.
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Application (%s) create", data.ID.ValueString()), err.Error())
 
 		return
@@ -199,7 +438,33 @@ func (r *applicationResource) Create(ctx context.Context, request resource.Creat
 	}
 
 	// Additional fields.
-	data.CurrentVersion = fwflex.Int32ToFramework(ctx, app.LatestVersion.ApplicationVersion)
+	data.ApplicationARN = flattenApplicationARN(r.Meta(), app)
+	// LatestVersion may not be set yet if not waiting for the application.
+	data.CurrentVersion = flattenApplicationVersion(aws.ToInt32(output.ApplicationVersion))
+
+	versions, err := findApplicationVersionsByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s) versions", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, versions, &data.Versions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	environmentIDs, err := findDeployedEnvironmentIDsByApplicationID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s) deployments", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.DeployedEnvironmentIDs = fwflex.FlattenFrameworkStringValueListOfString(ctx, environmentIDs)
+	data.EnvironmentID = flattenDeployedEnvironmentID(environmentIDs)
 
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
@@ -219,7 +484,7 @@ func (r *applicationResource) Read(ctx context.Context, request resource.ReadReq
 
 	conn := r.Meta().M2Client(ctx)
 
-	outputGA, err := findApplicationByID(ctx, conn, data.ID.ValueString())
+	outputGA, err := findApplicationByIDSettingTagsOut(ctx, conn, data.ID.ValueString())
 
 	if tfresource.NotFound(err) {
 		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
@@ -234,27 +499,96 @@ func (r *applicationResource) Read(ctx context.Context, request resource.ReadReq
 		return
 	}
 
-	applicationVersion := aws.ToInt32(outputGA.LatestVersion.ApplicationVersion)
-	outputGAV, err := findApplicationVersionByTwoPartKey(ctx, conn, data.ID.ValueString(), applicationVersion)
+	var latestVersion *int32
+	if outputGA.LatestVersion != nil {
+		latestVersion = outputGA.LatestVersion.ApplicationVersion
+	}
+
+	outputGAV, err := findApplicationVersionByCurrentOrLatest(ctx, conn, data.ID.ValueString(), data.CurrentVersion, latestVersion)
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s) version (%d)", data.ID.ValueString(), applicationVersion), err.Error())
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s) version", data.ID.ValueString()), err.Error())
 
 		return
 	}
 
 	// Set attributes for import.
-	response.Diagnostics.Append(fwflex.Flatten(ctx, outputGA, &data)...)
+	response.Diagnostics.Append(setApplicationReadOutputState(ctx, r.Meta(), outputGA, &data)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
 	// Additional fields.
-	data.CurrentVersion = fwflex.Int32ToFramework(ctx, outputGAV.ApplicationVersion)
-	data.Definition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &definitionModel{
-		Content:    fwflex.StringToFramework(ctx, outputGAV.DefinitionContent),
-		S3Location: types.StringNull(),
-	})
+	data.CurrentVersion = flattenApplicationVersion(aws.ToInt32(outputGAV.ApplicationVersion))
+
+	definitionData, diags := data.Definition.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API only returns the resolved definition content, so keep any configured S3 location or content file.
+	switch {
+	case definitionData != nil && !definitionData.ContentBase64.IsNull():
+		data.Definition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &definitionModel{
+			Content:              types.StringNull(),
+			ContentBase64:        types.StringValue(base64.StdEncoding.EncodeToString([]byte(aws.ToString(outputGAV.DefinitionContent)))),
+			ContentFile:          types.StringNull(),
+			ContentFileHash:      types.StringNull(),
+			NormalizedS3Location: types.StringNull(),
+			S3Bucket:             types.StringNull(),
+			S3Key:                types.StringNull(),
+			S3Location:           types.StringNull(),
+			S3ObjectVersion:      types.StringNull(),
+			StagedS3Location:     types.StringNull(),
+			StagingBucket:        types.StringNull(),
+		})
+	case definitionData == nil || (definitionData.S3Location.IsNull() && definitionData.S3Bucket.IsNull() && definitionData.ContentFile.IsNull()):
+		stagedS3Location, stagingBucket := types.StringNull(), types.StringNull()
+		if definitionData != nil {
+			stagedS3Location, stagingBucket = definitionData.StagedS3Location, definitionData.StagingBucket
+		}
+		data.Definition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &definitionModel{
+			Content:              fwflex.StringToFramework(ctx, outputGAV.DefinitionContent),
+			ContentBase64:        types.StringNull(),
+			ContentFile:          types.StringNull(),
+			ContentFileHash:      types.StringNull(),
+			NormalizedS3Location: types.StringNull(),
+			S3Bucket:             types.StringNull(),
+			S3Key:                types.StringNull(),
+			S3Location:           types.StringNull(),
+			S3ObjectVersion:      types.StringNull(),
+			StagedS3Location:     stagedS3Location,
+			StagingBucket:        stagingBucket,
+		})
+	default:
+		definitionData.NormalizedS3Location = flattenDefinitionNormalizedS3Location(definitionData)
+		data.Definition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, definitionData)
+	}
+
+	versions, err := findApplicationVersionsByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s) versions", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, versions, &data.Versions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	environmentIDs, err := findDeployedEnvironmentIDsByApplicationID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s) deployments", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.DeployedEnvironmentIDs = fwflex.FlattenFrameworkStringValueListOfString(ctx, environmentIDs)
+	data.EnvironmentID = flattenDeployedEnvironmentID(environmentIDs)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -272,23 +606,58 @@ func (r *applicationResource) Update(ctx context.Context, request resource.Updat
 
 	conn := r.Meta().M2Client(ctx)
 
-	if !new.Definition.Equal(old.Definition) || !new.Description.Equal(old.Description) {
+	// UpdateApplication returns an unhelpful ConflictException if the application can't be updated,
+	// for example while a previous update's version is still being created.
+	if applicationUpdateCreatesVersion(old, new) {
+		if _, err := waitApplicationUpdatable(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Mainframe Modernization Application (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	switch {
+	case !new.Definition.Equal(old.Definition) || !new.TriggerVersionReplacement.Equal(old.TriggerVersionReplacement):
+		currentVersion, err := expandApplicationVersion(old.CurrentVersion)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Mainframe Modernization Application (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
 		input := &m2.UpdateApplicationInput{
 			ApplicationId:             fwflex.StringFromFramework(ctx, new.ID),
-			CurrentApplicationVersion: fwflex.Int32FromFramework(ctx, old.CurrentVersion),
+			CurrentApplicationVersion: aws.Int32(currentVersion),
 		}
 
-		if !new.Definition.Equal(old.Definition) {
-			// AutoFlEx doesn't yet handle union types.
-			if !new.Definition.IsNull() {
-				definitionData, diags := new.Definition.ToPtr(ctx)
-				response.Diagnostics.Append(diags...)
-				if response.Diagnostics.HasError() {
+		// AutoFlEx doesn't yet handle union types.
+		if !new.Definition.IsNull() {
+			definitionData, diags := new.Definition.ToPtr(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			definitionData.StagedS3Location = flattenDefinitionStagedS3Location(new.Name.ValueString(), definitionData)
+			if !definitionData.StagedS3Location.IsNull() {
+				if err := stageDefinitionContent(ctx, r.Meta().S3Client(ctx), definitionData); err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("updating Mainframe Modernization Application (%s)", new.ID.ValueString()), err.Error())
+
 					return
 				}
+			}
+			new.Definition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, definitionData)
+
+			definition, err := expandDefinition(definitionData)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Mainframe Modernization Application (%s)", new.ID.ValueString()), err.Error())
 
-				input.Definition = expandDefinition(definitionData)
+				return
 			}
+
+			input.Definition = definition
 		}
 
 		if !new.Description.Equal(old.Description) {
@@ -298,7 +667,8 @@ func (r *applicationResource) Update(ctx context.Context, request resource.Updat
 		outputUA, err := conn.UpdateApplication(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("updating Mainframe Modernization Application (%s)", new.ID.ValueString()), err.Error())
+			definitionData, _ := new.Definition.ToPtr(ctx)
+			response.Diagnostics.Append(applicationErrorDiagnostic(fmt.Sprintf("updating Mainframe Modernization Application (%s)", new.ID.ValueString()), err, definitionData))
 
 			return
 		}
@@ -310,11 +680,44 @@ func (r *applicationResource) Update(ctx context.Context, request resource.Updat
 			return
 		}
 
-		new.CurrentVersion = types.Int64Value(int64(applicationVersion))
-	} else {
+		new.CurrentVersion = flattenApplicationVersion(applicationVersion)
+
+		response.Diagnostics.Append(deleteReplacedStagedDefinitionContent(ctx, r.Meta().S3Client(ctx), old, new)...)
+	case !new.Description.Equal(old.Description):
+		currentVersion, err := expandApplicationVersion(old.CurrentVersion)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Mainframe Modernization Application (%s) description", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		applicationVersion, err := updateApplicationDescription(ctx, conn, new.ID.ValueString(), currentVersion, fwflex.StringFromFramework(ctx, new.Description), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Mainframe Modernization Application (%s) description", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.CurrentVersion = flattenApplicationVersion(applicationVersion)
+	default:
 		new.CurrentVersion = old.CurrentVersion
 	}
 
+	versions, err := findApplicationVersionsByID(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s) versions", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, versions, &new.Versions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
@@ -325,11 +728,28 @@ func (r *applicationResource) Delete(ctx context.Context, request resource.Delet
 		return
 	}
 
+	// Deletion protection is enforced by the provider, not by Mainframe Modernization.
+	if data.DeletionProtection.ValueBool() {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("deleting Mainframe Modernization Application (%s)", data.ID.ValueString()),
+			"deletion_protection is enabled. Set deletion_protection to false and apply before destroying the application.",
+		)
+
+		return
+	}
+
 	conn := r.Meta().M2Client(ctx)
 
-	_, err := conn.DeleteApplication(ctx, &m2.DeleteApplicationInput{
-		ApplicationId: aws.String(data.ID.ValueString()),
-	})
+	// Unset means true, so that existing state doesn't plan a change.
+	if data.StopBeforeDelete.IsNull() || data.StopBeforeDelete.ValueBool() {
+		if _, err := stopApplicationBeforeDelete(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping Mainframe Modernization Application (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	err := deleteApplication(ctx, conn, data.ID.ValueString())
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return
@@ -346,79 +766,952 @@ func (r *applicationResource) Delete(ctx context.Context, request resource.Delet
 
 		return
 	}
-}
 
-func (r *applicationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	r.SetTagsAll(ctx, request, response)
+	if definitionData, diags := data.Definition.ToPtr(ctx); !diags.HasError() && definitionData != nil && !definitionData.StagedS3Location.IsNull() {
+		if err := deleteStagedDefinitionContent(ctx, r.Meta().S3Client(ctx), definitionData.StagedS3Location.ValueString()); err != nil {
+			response.Diagnostics.AddWarning("Staged Definition Content Not Deleted", err.Error())
+		}
+	}
 }
 
-func startApplication(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) { //nolint:unparam
-	input := &m2.StartApplicationInput{
-		ApplicationId: aws.String(id),
+// deleteApplication deletes the specified application.
+// A ConflictException is retried while the application isn't deployed, as it's returned intermittently while the application
+// finishes an internal transition, such as stopping. Otherwise the error names the environments it's deployed to.
+func deleteApplication(ctx context.Context, conn *m2.Client, id string) error {
+	var environmentIDs []string
+	var listErr error
+
+	_, err := tfresource.RetryGWhen(ctx, applicationDeleteConflictTimeout, func() (*m2.DeleteApplicationOutput, error) {
+		return conn.DeleteApplication(ctx, &m2.DeleteApplicationInput{
+			ApplicationId: aws.String(id),
+		})
+	}, func(err error) (bool, error) {
+		if !errs.IsA[*awstypes.ConflictException](err) {
+			return false, err
+		}
+
+		// A deployed application conflicts until its deployments are deleted, so retrying won't help.
+		environmentIDs, listErr = findDeployedEnvironmentIDsByApplicationID(ctx, conn, id)
+
+		return listErr == nil && len(environmentIDs) == 0, err
+	})
+
+	if !errs.IsA[*awstypes.ConflictException](err) {
+		return err
 	}
 
-	_, err := conn.StartApplication(ctx, input)
+	if len(environmentIDs) > 0 {
+		return fmt.Errorf("%w\n\nThe application is still deployed to environments (%s). Delete its deployments, such as aws_m2_deployment resources, before deleting the application.", err, strings.Join(environmentIDs, ", "))
+	}
 
-	if err != nil {
-		return nil, err
+	// Listing the deployments is best effort, as it only improves the error message.
+	if listErr != nil {
+		return fmt.Errorf("%w\n\nThe application is still deployed. Delete its deployments, such as aws_m2_deployment resources, before deleting the application.", err)
 	}
 
-	return waitApplicationRunning(ctx, conn, id, timeout)
+	return fmt.Errorf("%w\n\nThe application isn't deployed, but still couldn't be deleted after %s.", err, applicationDeleteConflictTimeout)
 }
 
-func stopApplicationIfRunning(ctx context.Context, conn *m2.Client, id string, forceStop bool, timeout time.Duration) (*m2.GetApplicationOutput, error) { //nolint:unparam
-	app, err := findApplicationByID(ctx, conn, id)
+const (
+	// How long a ConflictException from DeleteApplication is retried for while the application isn't deployed.
+	applicationDeleteConflictTimeout = 5 * time.Minute
+)
 
-	if tfresource.NotFound(err) {
-		return nil, nil
+func (r *applicationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+
+	if request.Plan.Raw.IsNull() {
+		return
 	}
 
-	if err != nil {
-		return nil, err
+	var roleARN fwtypes.ARN
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRoleARN), &roleARN)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if app.Status != awstypes.ApplicationLifecycleRunning {
-		return nil, nil
+	if !roleARN.IsNull() && !roleARN.IsUnknown() {
+		response.Diagnostics.Append(roleARNPartitionDiagnostics(roleARN.ValueString(), r.Meta().Partition)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var allowCrossAccount types.Bool
+		response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("allow_cross_account"), &allowCrossAccount)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !allowCrossAccount.ValueBool() {
+			response.Diagnostics.Append(roleARNAccountDiagnostics(roleARN.ValueString(), r.Meta().AccountID)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
 	}
 
-	input := &m2.StopApplicationInput{
-		ApplicationId: aws.String(id),
-		ForceStop:     forceStop,
+	// Checking the role's trust policy requires calling IAM, so it's opt-in.
+	// The role can't be changed in-place, so only check it when creating.
+	if request.State.Raw.IsNull() {
+		var validateRoleTrustPolicy types.Bool
+		response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("validate_role_trust_policy"), &validateRoleTrustPolicy)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if validateRoleTrustPolicy.ValueBool() && !roleARN.IsNull() && !roleARN.IsUnknown() {
+			response.Diagnostics.Append(roleTrustPolicyWarnings(ctx, r.Meta().IAMClient(ctx), roleARN.ValueString())...)
+		}
 	}
 
-	_, err = conn.StopApplication(ctx, input)
+	var definition fwtypes.ListNestedObjectValueOf[definitionModel]
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("definition"), &definition)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
-		return nil, err
+	if definition.IsNull() || definition.IsUnknown() {
+		return
 	}
 
-	return waitApplicationStopped(ctx, conn, id, timeout)
-}
+	definitionData, diags := definition.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-func findApplicationByID(ctx context.Context, conn *m2.Client, id string) (*m2.GetApplicationOutput, error) {
-	input := &m2.GetApplicationInput{
-		ApplicationId: aws.String(id),
+	if definitionData == nil {
+		return
 	}
 
-	output, err := conn.GetApplication(ctx, input)
+	// The S3 location sent to the API is fully determined by configuration, so it's known at plan time.
+	if s3Location := definitionS3Location(definitionData); !s3Location.IsUnknown() && !definitionData.S3ObjectVersion.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("definition").AtListIndex(0).AtName("normalized_s3_location"), flattenDefinitionNormalizedS3Location(definitionData))...)
 
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
+		// Checking the definition object requires calling S3, so it's opt-in.
+		var validateDefinitionS3Location types.Bool
+		response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("validate_definition_s3_location"), &validateDefinitionS3Location)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if request.State.Raw.IsNull() && validateDefinitionS3Location.ValueBool() && !s3Location.IsNull() {
+			response.Diagnostics.Append(definitionS3LocationWarnings(ctx, r.Meta().S3Client(ctx), s3Location.ValueString(), definitionData.S3ObjectVersion.ValueString(), r.Meta().Region)...)
 		}
 	}
 
-	if err != nil {
-		return nil, err
+	// The staged S3 location is derived from the application name and content, so it's known at plan time if they are.
+	var name types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrName), &name)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if output == nil || output.ApplicationId == nil {
-		return nil, tfresource.NewEmptyResultError(input)
+	if !name.IsUnknown() && !definitionData.Content.IsUnknown() && !definitionData.StagingBucket.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("definition").AtListIndex(0).AtName("staged_s3_location"), flattenDefinitionStagedS3Location(name.ValueString(), definitionData))...)
 	}
 
-	return output, nil
-}
+	if v := definitionData.ContentBase64; !v.IsNull() && !v.IsUnknown() {
+		if _, err := decodeDefinitionContentBase64(v.ValueString()); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("definition").AtListIndex(0).AtName("content_base64"), "Invalid Definition Content Base64", err.Error())
+
+			return
+		}
+	}
+
+	// Hash the content file so that changes to its contents are planned as updates.
+	if !definitionData.ContentFile.IsUnknown() {
+		hash := types.StringNull()
+
+		if !definitionData.ContentFile.IsNull() {
+			v, err := definitionContentFileHash(definitionData.ContentFile.ValueString())
+
+			if err != nil {
+				response.Diagnostics.AddAttributeError(path.Root("definition").AtListIndex(0).AtName("content_file"), "Invalid Definition Content File", err.Error())
+
+				return
+			}
+
+			hash = types.StringValue(v)
+		}
+
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("definition").AtListIndex(0).AtName("content_file_hash"), hash)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	var old, new applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(definitionRedeploymentWarnings(ctx, old, new)...)
+}
+
+func (r *applicationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	id, s3Location, s3ObjectVersion, err := parseApplicationImportID(request.ID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("importing Mainframe Modernization Application (%s)", request.ID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), id)...)
+
+	// The API only returns the resolved definition content, so an S3-sourced definition's location must be part of the import ID.
+	// Read keeps a definition's S3 location, so the imported resource doesn't plan a switch to inline content.
+	if s3Location != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("definition"), fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &definitionModel{
+			Content:              types.StringNull(),
+			ContentBase64:        types.StringNull(),
+			ContentFile:          types.StringNull(),
+			ContentFileHash:      types.StringNull(),
+			NormalizedS3Location: types.StringNull(),
+			S3Bucket:             types.StringNull(),
+			S3Key:                types.StringNull(),
+			S3Location:           types.StringValue(s3Location),
+			S3ObjectVersion:      fwflex.StringValueToFramework(ctx, s3ObjectVersion),
+			StagedS3Location:     types.StringNull(),
+			StagingBucket:        types.StringNull(),
+		}))...)
+	}
+}
+
+// applicationUpdateCreatesVersion returns whether updating the application from old to new calls UpdateApplication, creating a new application version.
+// Tags are updated by the transparent tagging layer and never create a new version.
+func applicationUpdateCreatesVersion(old, new applicationResourceModel) bool {
+	return !new.Definition.Equal(old.Definition) || !new.Description.Equal(old.Description) || !new.TriggerVersionReplacement.Equal(old.TriggerVersionReplacement)
+}
+
+// definitionRedeploymentWarnings returns a warning if the definition of a deployed application is changing.
+// A new application version isn't deployed until the deployment's application_version is updated.
+func definitionRedeploymentWarnings(ctx context.Context, old, new applicationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if new.Definition.Equal(old.Definition) {
+		return diags
+	}
+
+	environmentIDs := fwflex.ExpandFrameworkStringValueList(ctx, old.DeployedEnvironmentIDs)
+	if len(environmentIDs) == 0 {
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("definition"),
+		"Application Redeployment Required",
+		fmt.Sprintf("Changing the definition creates a new application version, which must be redeployed to environment(s) %s. "+
+			"Redeployment stops the application and may cause downtime.", strings.Join(environmentIDs, ", ")),
+	)
+
+	return diags
+}
+
+func (r *applicationResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		applicationDefinitionEngineTypeValidator{},
+		applicationRoleARNRequiredValidator{},
+	}
+}
+
+var _ resource.ConfigValidator = applicationDefinitionEngineTypeValidator{}
+
+// applicationDefinitionEngineTypeValidator flags inline definitions that are missing fields required by the application's engine type.
+type applicationDefinitionEngineTypeValidator struct{}
+
+func (v applicationDefinitionEngineTypeValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v applicationDefinitionEngineTypeValidator) MarkdownDescription(context.Context) string {
+	return "inline definitions must contain the fields required by engine_type"
+}
+
+func (v applicationDefinitionEngineTypeValidator) ValidateResource(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.EngineType.IsNull() || data.EngineType.IsUnknown() {
+		return
+	}
+
+	if data.Definition.IsNull() || data.Definition.IsUnknown() {
+		return
+	}
+
+	definitionData, diags := data.Definition.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || definitionData == nil {
+		return
+	}
+
+	var content string
+	var attributePath path.Path
+	switch {
+	case !definitionData.Content.IsNull() && !definitionData.Content.IsUnknown():
+		content = definitionData.Content.ValueString()
+		attributePath = path.Root("definition").AtListIndex(0).AtName(names.AttrContent)
+	case !definitionData.ContentBase64.IsNull() && !definitionData.ContentBase64.IsUnknown():
+		v, err := decodeDefinitionContentBase64(definitionData.ContentBase64.ValueString())
+		if err != nil {
+			return
+		}
+		content = v
+		attributePath = path.Root("definition").AtListIndex(0).AtName("content_base64")
+	default:
+		return
+	}
+
+	if missing := applicationDefinitionMissingFields(data.EngineType.ValueEnum(), content); len(missing) > 0 {
+		response.Diagnostics.AddAttributeError(
+			attributePath,
+			"Invalid Application Definition",
+			fmt.Sprintf("%s application definitions must contain %s.", data.EngineType.ValueString(), strings.Join(missing, ", ")),
+		)
+	}
+}
+
+// bluageDefinitionRequiredFields are the fields, as dot-separated paths, that every Blu Age application definition must contain.
+var bluageDefinitionRequiredFields = []string{
+	"definition.listeners",
+	"definition.ba-application.app-location",
+}
+
+// applicationDefinitionMissingFields returns the fields required for the specified engine type that are missing from the
+// specified inline definition content.
+// Only Blu Age definitions are checked, and content that isn't a JSON object is left for the API to reject.
+func applicationDefinitionMissingFields(engineType awstypes.EngineType, content string) []string {
+	if engineType != awstypes.EngineTypeBluage {
+		return nil
+	}
+
+	var definition map[string]any
+	if err := json.Unmarshal([]byte(content), &definition); err != nil {
+		return nil
+	}
+
+	var missing []string
+	for _, field := range bluageDefinitionRequiredFields {
+		var v any = definition
+		for _, name := range strings.Split(field, ".") {
+			m, ok := v.(map[string]any)
+			if !ok {
+				v = nil
+				break
+			}
+			v = m[name]
+		}
+
+		if v == nil {
+			missing = append(missing, field)
+		}
+	}
+
+	return missing
+}
+
+var _ resource.ConfigValidator = applicationRoleARNRequiredValidator{}
+
+// applicationRoleARNRequiredValidator flags Micro Focus applications whose inline definition
+// references Secrets Manager secrets but which don't specify an execution role.
+type applicationRoleARNRequiredValidator struct{}
+
+func (v applicationRoleARNRequiredValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v applicationRoleARNRequiredValidator) MarkdownDescription(context.Context) string {
+	return "role_arn must be configured for microfocus applications whose definition references Secrets Manager secrets"
+}
+
+func (v applicationRoleARNRequiredValidator) ValidateResource(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.EngineType.IsNull() || data.EngineType.IsUnknown() || !data.RoleARN.IsNull() {
+		return
+	}
+
+	if data.Definition.IsNull() || data.Definition.IsUnknown() {
+		return
+	}
+
+	definitionData, diags := data.Definition.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || definitionData == nil {
+		return
+	}
+
+	if definitionData.Content.IsNull() || definitionData.Content.IsUnknown() {
+		return
+	}
+
+	if applicationDefinitionRequiresRole(data.EngineType.ValueEnum(), definitionData.Content.ValueString()) {
+		response.Diagnostics.AddAttributeError(
+			path.Root(names.AttrRoleARN),
+			"Missing Attribute Configuration",
+			"role_arn must be configured when a microfocus application definition references AWS Secrets Manager secrets, as the application needs an execution role to read them.",
+		)
+	}
+}
+
+var (
+	secretsManagerARNRegexp = regexache.MustCompile(`arn:aws[a-z-]*:secretsmanager:`)
+)
+
+// applicationDefinitionRequiresRole returns whether an application with the specified engine type and
+// inline definition content needs an execution role.
+// Only Secrets Manager references from Micro Focus definitions are considered, to avoid false positives.
+func applicationDefinitionRequiresRole(engineType awstypes.EngineType, content string) bool {
+	return engineType == awstypes.EngineTypeMicrofocus && secretsManagerARNRegexp.MatchString(content)
+}
+
+// definitionS3LocationWarnings returns a warning if the specified definition S3 object can't be read,
+// or if its bucket isn't in the specified Region, as Mainframe Modernization often can't read definitions from other Regions.
+// The object is read with the provider's credentials, not the application's role, so only its existence is confirmed.
+func definitionS3LocationWarnings(ctx context.Context, conn *s3.Client, s3Location, s3ObjectVersion, region string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, key, err := definitionS3LocationBucketAndKey(s3Location)
+
+	if err != nil {
+		diags.AddAttributeWarning(path.Root("definition").AtListIndex(0).AtName("s3_location"), "Unable to Validate Definition S3 Location", err.Error())
+
+		return diags
+	}
+
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if s3ObjectVersion != "" {
+		input.VersionId = aws.String(s3ObjectVersion)
+	}
+
+	if _, err := conn.HeadObject(ctx, input); err != nil {
+		diags.AddAttributeWarning(
+			path.Root("definition").AtListIndex(0).AtName("s3_location"),
+			"Definition S3 Object Can't Be Read",
+			fmt.Sprintf("reading S3 Object (%s): %s. The application will fail to be created if Mainframe Modernization can't read its definition.", s3Location, err),
+		)
+
+		return diags
+	}
+
+	output, err := conn.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	})
+
+	// The object could be read, so the bucket's Region not being known isn't worth a warning.
+	if err != nil || region == "" {
+		return diags
+	}
+
+	if bucketRegion := aws.ToString(output.BucketRegion); bucketRegion != "" && bucketRegion != region {
+		diags.AddAttributeWarning(
+			path.Root("definition").AtListIndex(0).AtName("s3_location"),
+			"Definition S3 Bucket In Different Region",
+			fmt.Sprintf("S3 Bucket (%s) is in %s, not %s. Mainframe Modernization may be unable to read application definitions from a bucket in another Region.", bucket, bucketRegion, region),
+		)
+	}
+
+	return diags
+}
+
+// definitionS3LocationBucketAndKey returns the bucket and key of the specified definition S3 URI.
+func definitionS3LocationBucketAndKey(s3Location string) (string, string, error) {
+	u, err := url.Parse(normalizeDefinitionS3Location(s3Location, ""))
+
+	if err != nil {
+		return "", "", err
+	}
+
+	if bucket, key := u.Host, strings.TrimPrefix(u.Path, "/"); u.Scheme == "s3" && bucket != "" && key != "" {
+		return bucket, key, nil
+	}
+
+	return "", "", fmt.Errorf("%q is not an S3 URI of the form s3://bucket/key", s3Location)
+}
+
+// definitionS3LocationValidator validates that a definition S3 location is an S3 URI of the form s3://bucket/key.
+func definitionS3LocationValidator() validator.String {
+	return definitionS3LocationValidatorImpl{}
+}
+
+type definitionS3LocationValidatorImpl struct{}
+
+func (v definitionS3LocationValidatorImpl) Description(_ context.Context) string {
+	return "value must be an S3 URI of the form s3://bucket/key"
+}
+
+func (v definitionS3LocationValidatorImpl) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v definitionS3LocationValidatorImpl) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, _, err := definitionS3LocationBucketAndKey(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Definition S3 Location", err.Error())
+	}
+}
+
+// definitionS3ObjectVersionValidator returns a validator that checks that an S3 object version is only set for an S3-sourced definition.
+func definitionS3ObjectVersionValidator() validator.String {
+	return definitionS3ObjectVersionValidatorImpl{}
+}
+
+type definitionS3ObjectVersionValidatorImpl struct{}
+
+func (v definitionS3ObjectVersionValidatorImpl) Description(_ context.Context) string {
+	return "s3_location or s3_bucket must also be configured"
+}
+
+func (v definitionS3ObjectVersionValidatorImpl) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v definitionS3ObjectVersionValidatorImpl) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() {
+		return
+	}
+
+	for _, name := range []string{"s3_location", "s3_bucket"} {
+		var value types.String
+		response.Diagnostics.Append(request.Config.GetAttribute(ctx, request.Path.ParentPath().AtName(name), &value)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !value.IsNull() {
+			return
+		}
+	}
+
+	response.Diagnostics.AddAttributeError(request.Path, "Missing Definition S3 Location", fmt.Sprintf("s3_object_version requires %s.", v.Description(ctx)))
+}
+
+// roleARNPartitionDiagnostics returns an error if the specified role ARN isn't in the provider's partition.
+// Mainframe Modernization only rejects such a role once the application is being created.
+func roleARNPartitionDiagnostics(roleARN, partition string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, err := arn.Parse(roleARN)
+
+	if err != nil {
+		return diags
+	}
+
+	if v.Partition != partition {
+		diags.AddAttributeError(
+			path.Root(names.AttrRoleARN),
+			"Invalid Role ARN Partition",
+			fmt.Sprintf("IAM Role (%s) is in the %q partition, but the provider is configured for the %q partition.", roleARN, v.Partition, partition),
+		)
+	}
+
+	return diags
+}
+
+// roleARNAccountDiagnostics returns an error if the specified role ARN isn't in the provider's account.
+// The check is skipped if the provider's account ID isn't known, e.g. when skip_requesting_account_id is set.
+func roleARNAccountDiagnostics(roleARN, accountID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, err := arn.Parse(roleARN)
+
+	if err != nil || accountID == "" {
+		return diags
+	}
+
+	if v.AccountID != accountID {
+		diags.AddAttributeError(
+			path.Root(names.AttrRoleARN),
+			"Invalid Role ARN Account",
+			fmt.Sprintf("IAM Role (%s) is in account %s, but the provider is configured for account %s. Set allow_cross_account to use a role in another account.", roleARN, v.AccountID, accountID),
+		)
+	}
+
+	return diags
+}
+
+// roleTrustPolicyWarnings returns a warning if the specified role's trust policy doesn't allow Mainframe Modernization to assume it.
+// Failing to check the trust policy is also a warning, as the role may not be readable by the caller.
+func roleTrustPolicyWarnings(ctx context.Context, conn *iam.Client, roleARN string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ok, err := roleTrustsService(ctx, conn, roleARN, servicePrincipal)
+
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root(names.AttrRoleARN),
+			"Unable to Validate Role Trust Policy",
+			fmt.Sprintf("reading IAM Role (%s) trust policy: %s", roleARN, err),
+		)
+
+		return diags
+	}
+
+	if !ok {
+		diags.AddAttributeWarning(
+			path.Root(names.AttrRoleARN),
+			"Role Trust Policy Does Not Allow Mainframe Modernization",
+			fmt.Sprintf("The trust policy of IAM Role (%s) does not allow %s to assume the role, so the application will fail to be created.", roleARN, servicePrincipal),
+		)
+	}
+
+	return diags
+}
+
+func roleTrustsService(ctx context.Context, conn *iam.Client, roleARN, service string) (bool, error) {
+	role, err := tfiam.FindRoleByName(ctx, conn, roleNameFromARN(roleARN))
+
+	if err != nil {
+		return false, err
+	}
+
+	document, err := url.QueryUnescape(aws.ToString(role.AssumeRolePolicyDocument))
+
+	if err != nil {
+		return false, err
+	}
+
+	return roleTrustPolicyAllowsService(document, service)
+}
+
+// roleTrustPolicyAllowsService returns whether the specified role trust policy document allows the specified service principal to assume the role.
+// Conditions aren't evaluated.
+func roleTrustPolicyAllowsService(document, service string) (bool, error) {
+	var policy tfiam.IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return false, fmt.Errorf("parsing trust policy: %w", err)
+	}
+
+	for _, statement := range policy.Statements {
+		if !strings.EqualFold(statement.Effect, "Allow") {
+			continue
+		}
+
+		if !slices.ContainsFunc(policyStringOrSlice(statement.Actions), func(action string) bool {
+			return action == "*" || strings.EqualFold(action, "sts:*") || strings.EqualFold(action, "sts:AssumeRole")
+		}) {
+			continue
+		}
+
+		for _, principal := range statement.Principals {
+			if principal.Type == "*" {
+				return true, nil
+			}
+
+			if principal.Type == "Service" && slices.Contains(policyStringOrSlice(principal.Identifiers), service) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// policyStringOrSlice returns a policy element that may be a single string or a list of strings as a slice.
+func policyStringOrSlice(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
+// roleNameFromARN returns the name of the IAM role with the specified ARN, ignoring any path.
+func roleNameFromARN(roleARN string) string {
+	return roleARN[strings.LastIndex(roleARN, "/")+1:]
+}
+
+// applicationCreateTimeout returns any configured Create timeout value or the engine type's default value.
+// Blu Age applications can take considerably longer to provision than Micro Focus ones.
+func applicationCreateTimeout(ctx context.Context, timeouts timeouts.Value, engineType awstypes.EngineType) time.Duration {
+	defaultTimeout := 30 * time.Minute
+	if engineType == awstypes.EngineTypeBluage {
+		defaultTimeout = 60 * time.Minute
+	}
+
+	timeout, diags := timeouts.Create(ctx, defaultTimeout)
+
+	if diags.HasError() {
+		return defaultTimeout
+	}
+
+	return timeout
+}
+
+// updateApplicationDescription updates only an application's description.
+// There is no metadata-only update API, so the application version returned by UpdateApplication is used as-is
+// and the waiter only runs if that version differs from the current one.
+func updateApplicationDescription(ctx context.Context, conn *m2.Client, id string, currentVersion int32, description *string, timeout time.Duration) (int32, error) {
+	input := &m2.UpdateApplicationInput{
+		ApplicationId:             aws.String(id),
+		CurrentApplicationVersion: aws.Int32(currentVersion),
+		Description:               description,
+	}
+
+	output, err := conn.UpdateApplication(ctx, input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	applicationVersion := aws.ToInt32(output.ApplicationVersion)
+	if applicationVersion == currentVersion {
+		return applicationVersion, nil
+	}
+
+	if _, err := waitApplicationUpdated(ctx, conn, id, applicationVersion, timeout); err != nil {
+		return 0, fmt.Errorf("waiting for version (%d): %w", applicationVersion, err)
+	}
+
+	return applicationVersion, nil
+}
+
+func startApplication(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) { //nolint:unparam
+	input := &m2.StartApplicationInput{
+		ApplicationId: aws.String(id),
+	}
+
+	_, err := conn.StartApplication(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return waitApplicationRunning(ctx, conn, id, timeout)
+}
+
+func stopApplicationIfRunning(ctx context.Context, conn *m2.Client, id string, forceStop bool, timeout time.Duration) (*m2.GetApplicationOutput, error) { //nolint:unparam
+	app, err := findApplicationByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if app.Status != awstypes.ApplicationLifecycleRunning {
+		return nil, nil
+	}
+
+	return stopApplication(ctx, conn, id, forceStop, applicationStoppingBlockedTimeout, timeout)
+}
+
+// stopApplicationBeforeDelete stops the specified application if it's starting, running or already stopping, as DeleteApplication fails while it's running.
+// A starting application can't be stopped, so it's waited for until it's running first.
+func stopApplicationBeforeDelete(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	deadline := time.Now().Add(timeout)
+
+	app, err := findApplicationByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch app.Status {
+	case awstypes.ApplicationLifecycleStarting:
+		if _, err := waitApplicationRunning(ctx, conn, id, time.Until(deadline)); err != nil {
+			return nil, err
+		}
+	case awstypes.ApplicationLifecycleRunning:
+	case awstypes.ApplicationLifecycleStopping:
+		return waitApplicationStopped(ctx, conn, id, time.Until(deadline))
+	default:
+		return nil, nil
+	}
+
+	return stopApplication(ctx, conn, id, false, applicationStoppingBlockedTimeout, time.Until(deadline))
+}
+
+const (
+	// How long an application can be stopping before it's considered blocked.
+	applicationStoppingBlockedTimeout = 5 * time.Minute
+)
+
+// stopApplication stops the application, escalating to a forced stop if forceStop is set and the
+// application has been stopping for longer than blockedTimeout.
+// Without forceStop, an application blocked by in-progress batch job executions is reported as an error naming them.
+func stopApplication(ctx context.Context, conn *m2.Client, id string, forceStop bool, blockedTimeout, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	deadline := time.Now().Add(timeout)
+
+	// Stop gracefully first so that batch jobs that are about to finish aren't interrupted.
+	input := &m2.StopApplicationInput{
+		ApplicationId: aws.String(id),
+	}
+
+	if _, err := conn.StopApplication(ctx, input); err != nil {
+		return nil, err
+	}
+
+	output, err := waitApplicationStopped(ctx, conn, id, min(blockedTimeout, timeout))
+
+	if !errs.IsA[*retry.TimeoutError](err) || blockedTimeout >= timeout {
+		return output, err
+	}
+
+	if forceStop {
+		input.ForceStop = true
+
+		if _, err := conn.StopApplication(ctx, input); err != nil {
+			return nil, err
+		}
+
+		return waitApplicationStopped(ctx, conn, id, time.Until(deadline))
+	}
+
+	executions, err := findInProgressBatchJobExecutionsByApplicationID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(executions) > 0 {
+		blockers := tfslices.ApplyToAll(executions, func(v awstypes.BatchJobExecutionSummary) string {
+			return fmt.Sprintf("%s (%s)", aws.ToString(v.JobName), aws.ToString(v.ExecutionId))
+		})
+
+		return nil, fmt.Errorf("application (%s) has been stopping for more than %s, blocked by in-progress batch job execution(s) %s; set force_stop to force it to stop", id, blockedTimeout, strings.Join(blockers, ", "))
+	}
+
+	return waitApplicationStopped(ctx, conn, id, time.Until(deadline))
+}
+
+func findInProgressBatchJobExecutionsByApplicationID(ctx context.Context, conn *m2.Client, id string) ([]awstypes.BatchJobExecutionSummary, error) {
+	executions, err := findBatchJobExecutions(ctx, conn, &m2.ListBatchJobExecutionsInput{
+		ApplicationId: aws.String(id),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.Filter(executions, func(v awstypes.BatchJobExecutionSummary) bool {
+		return batchJobExecutionStatusInProgress(v.Status)
+	}), nil
+}
+
+// findApplicationByIDSettingTagsOut finds the application and sets its tags in Context.
+// GetApplication returns tags, so the transparent tagging layer doesn't call ListTagsForResource.
+func findApplicationByIDSettingTagsOut(ctx context.Context, conn *m2.Client, id string) (*m2.GetApplicationOutput, error) {
+	output, err := findApplicationByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	return output, nil
+}
+
+func findApplicationByID(ctx context.Context, conn *m2.Client, id string) (*m2.GetApplicationOutput, error) {
+	input := &m2.GetApplicationInput{
+		ApplicationId: aws.String(id),
+	}
+
+	output, err := conn.GetApplication(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ApplicationId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+const (
+	applicationVersionPropagationTimeout = 1 * time.Minute
+)
+
+// findApplicationVersionByCurrentOrLatest returns the application version recorded in state, so that its definition is read.
+// The latest version is returned if no version is recorded, e.g. on import, or if the recorded version no longer exists.
+// An application that is still being created, e.g. when create doesn't wait for it to be ready, may not report a latest version yet.
+// The recorded version is then returned, and it's an error if there is none.
+func findApplicationVersionByCurrentOrLatest(ctx context.Context, conn *m2.Client, id string, currentVersion types.Int64, latestVersion *int32) (*m2.GetApplicationVersionOutput, error) {
+	if latestVersion == nil {
+		version, err := expandApplicationVersion(currentVersion)
+
+		if err != nil {
+			return nil, fmt.Errorf("application (%s) has no latest version yet; refresh again once it has been created", id)
+		}
+
+		return findApplicationVersionByTwoPartKeyWithRetry(ctx, conn, id, version, applicationVersionPropagationTimeout)
+	}
+
+	if version, err := expandApplicationVersion(currentVersion); err == nil && version != aws.ToInt32(latestVersion) {
+		output, err := findApplicationVersionByTwoPartKey(ctx, conn, id, version)
+
+		if !tfresource.NotFound(err) {
+			return output, err
+		}
+	}
+
+	return findApplicationVersionByTwoPartKeyWithRetry(ctx, conn, id, aws.ToInt32(latestVersion), applicationVersionPropagationTimeout)
+}
+
+// findApplicationVersionByTwoPartKeyWithRetry retries NotFound errors, as a new application version may not be immediately readable.
+func findApplicationVersionByTwoPartKeyWithRetry(ctx context.Context, conn *m2.Client, id string, version int32, timeout time.Duration) (*m2.GetApplicationVersionOutput, error) {
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, timeout, func() (interface{}, error) {
+		return findApplicationVersionByTwoPartKey(ctx, conn, id, version)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*m2.GetApplicationVersionOutput), nil
+}
 
 func findApplicationVersionByTwoPartKey(ctx context.Context, conn *m2.Client, id string, version int32) (*m2.GetApplicationVersionOutput, error) {
 	input := &m2.GetApplicationVersionInput{
@@ -426,21 +1719,258 @@ func findApplicationVersionByTwoPartKey(ctx context.Context, conn *m2.Client, id
 		ApplicationVersion: aws.Int32(version),
 	}
 
-	output, err := conn.GetApplicationVersion(ctx, input)
+	output, err := conn.GetApplicationVersion(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// DefinitionContent isn't checked, as a version whose definition is read from S3 may have no inline content.
+	if output == nil || output.ApplicationVersion == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// setApplicationCreateOutputState sets the identifiers returned by CreateApplication in the specified state.
+// It's used when the application fails to become available, so that the tainted resource can still be destroyed or replaced.
+func setApplicationCreateOutputState(ctx context.Context, state *tfsdk.State, output *m2.CreateApplicationOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(state.SetAttribute(ctx, path.Root(names.AttrID), aws.ToString(output.ApplicationId))...)
+	diags.Append(state.SetAttribute(ctx, path.Root(names.AttrApplicationID), aws.ToString(output.ApplicationId))...)
+	diags.Append(state.SetAttribute(ctx, path.Root(names.AttrARN), aws.ToString(output.ApplicationArn))...)
+	diags.Append(state.SetAttribute(ctx, path.Root("current_version"), flattenApplicationVersion(aws.ToInt32(output.ApplicationVersion)))...)
+
+	return diags
+}
+
+// setApplicationReadOutputState sets the attributes returned by GetApplication in the specified model.
+// Values in state, such as an engine_type that has drifted, are overwritten so that the next plan reconciles them.
+func setApplicationReadOutputState(ctx context.Context, c *conns.AWSClient, output *m2.GetApplicationOutput, data *applicationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ApplicationARN = flattenApplicationARN(c, output)
+
+	return diags
+}
+
+// checkApplicationNameAvailable returns an error if an application with the specified name already exists.
+// CreateApplication only reports the conflict after the call, so this surfaces it before anything is created.
+func checkApplicationNameAvailable(ctx context.Context, conn *m2.Client, name string) error {
+	_, err := findApplicationByName(ctx, conn, name)
+
+	switch {
+	case errors.Is(err, tfresource.ErrTooManyResults):
+	case tfresource.NotFound(err):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	return fmt.Errorf("application named %s already exists", name)
+}
+
+func findApplicationByName(ctx context.Context, conn *m2.Client, name string) (*awstypes.ApplicationSummary, error) {
+	input := &m2.ListApplicationsInput{
+		Names: []string{name},
+	}
+
+	return findApplication(ctx, conn, input)
+}
+
+func findApplication(ctx context.Context, conn *m2.Client, input *m2.ListApplicationsInput) (*awstypes.ApplicationSummary, error) {
+	output, err := findApplications(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findApplications(ctx context.Context, conn *m2.Client, input *m2.ListApplicationsInput) ([]awstypes.ApplicationSummary, error) {
+	var output []awstypes.ApplicationSummary
+
+	pages := m2.NewListApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Applications...)
+	}
+
+	return output, nil
+}
+
+// findDeployedEnvironmentIDsByApplicationID returns the IDs of the environments the specified application is deployed to.
+// Failed deployments are ignored.
+func findDeployedEnvironmentIDsByApplicationID(ctx context.Context, conn *m2.Client, id string) ([]string, error) {
+	deployments, err := findDeployments(ctx, conn, &m2.ListDeploymentsInput{
+		ApplicationId: aws.String(id),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]string, 0)
+
+	for _, deployment := range deployments {
+		if deployment.Status == awstypes.DeploymentLifecycleFailed {
+			continue
+		}
+
+		if environmentID := aws.ToString(deployment.EnvironmentId); environmentID != "" && !slices.Contains(output, environmentID) {
+			output = append(output, environmentID)
+		}
+	}
+
+	slices.Sort(output)
+
+	return output, nil
+}
+
+// flattenApplicationVersion returns the Framework value of an application version.
+func flattenApplicationVersion(v int32) types.Int64 {
+	return types.Int64Value(int64(v))
+}
+
+// flattenApplicationARN returns the specified application's ARN.
+// Older API responses may not include the ARN, in which case it's constructed from the provider's partition, Region and account.
+func flattenApplicationARN(c *conns.AWSClient, output *m2.GetApplicationOutput) types.String {
+	if v := aws.ToString(output.ApplicationArn); v != "" {
+		return types.StringValue(v)
+	}
+
+	return types.StringValue(arn.ARN{
+		Partition: c.Partition,
+		Service:   "m2",
+		Region:    c.Region,
+		AccountID: c.AccountID,
+		Resource:  "app/" + aws.ToString(output.ApplicationId),
+	}.String())
+}
+
+// expandApplicationVersion returns the API value of an application version.
+// Versions are int32s in the API but Int64s in the schema, so out of range values are an error rather than wrapping.
+func expandApplicationVersion(v types.Int64) (int32, error) {
+	if v.IsNull() || v.IsUnknown() {
+		return 0, errors.New("application version is not known")
+	}
+
+	if v := v.ValueInt64(); v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, fmt.Errorf("application version (%d) is out of range", v)
+	}
+
+	return int32(v.ValueInt64()), nil
+}
+
+// flattenDeployedEnvironmentID returns the ID of the environment an application is deployed to.
+// It's null unless the application is deployed to exactly one environment.
+func flattenDeployedEnvironmentID(environmentIDs []string) types.String {
+	if len(environmentIDs) != 1 {
+		return types.StringNull()
+	}
+
+	return types.StringValue(environmentIDs[0])
+}
+
+func findApplicationVersionsByID(ctx context.Context, conn *m2.Client, id string) ([]awstypes.ApplicationVersionSummary, error) {
+	input := &m2.ListApplicationVersionsInput{
+		ApplicationId: aws.String(id),
+	}
+
+	return findApplicationVersions(ctx, conn, input)
+}
+
+func findApplicationVersions(ctx context.Context, conn *m2.Client, input *m2.ListApplicationVersionsInput) ([]awstypes.ApplicationVersionSummary, error) {
+	var output []awstypes.ApplicationVersionSummary
+
+	pages := m2.NewListApplicationVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ApplicationVersions...)
+	}
+
+	return output, nil
+}
+
+var (
+	applicationUpdatableStatuses = []awstypes.ApplicationLifecycle{
+		awstypes.ApplicationLifecycleCreated,
+		awstypes.ApplicationLifecycleAvailable,
+		awstypes.ApplicationLifecycleRunning,
+		awstypes.ApplicationLifecycleStopped,
+	}
+	// applicationSettlingStatuses are the lifecycle states that an application leaves for an updatable state on its own.
+	applicationSettlingStatuses = []awstypes.ApplicationLifecycle{
+		awstypes.ApplicationLifecycleCreating,
+		awstypes.ApplicationLifecycleStarting,
+		awstypes.ApplicationLifecycleStopping,
+	}
+)
+
+// waitApplicationUpdatable waits for the specified application to settle into a lifecycle state that allows updates,
+// and for any application version being created by a previous update to become available.
+// An error is returned if the application is in a state that it won't leave on its own, such as Failed.
+// UpdateApplication has no force option to override this, unlike UpdateEnvironment.
+func waitApplicationUpdatable(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(applicationSettlingStatuses...),
+		Target:  enum.Slice(applicationUpdatableStatuses...),
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	output, ok := outputRaw.(*m2.GetApplicationOutput)
 
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
+	if errs.IsA[*retry.UnexpectedStateError](err) && ok {
+		return nil, fmt.Errorf("cannot update application in state %s", output.Status)
 	}
 
 	if err != nil {
+		if ok {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+		}
+
 		return nil, err
 	}
 
-	if output == nil || output.ApplicationVersion == nil {
-		return nil, tfresource.NewEmptyResultError(input)
+	if v := output.LatestVersion; v != nil && v.Status == awstypes.ApplicationVersionLifecycleCreating {
+		if _, err := waitApplicationUpdated(ctx, conn, id, aws.ToInt32(v.ApplicationVersion), timeout); err != nil {
+			return nil, fmt.Errorf("waiting for application version (%d) from a previous update: %w", aws.ToInt32(v.ApplicationVersion), err)
+		}
 	}
 
 	return output, nil
@@ -462,9 +1992,20 @@ func statusApplication(ctx context.Context, conn *m2.Client, id string) retry.St
 	}
 }
 
-func statusApplicationVersion(ctx context.Context, conn *m2.Client, id string, version int32) retry.StateRefreshFunc {
+const (
+	// applicationStatusEnvironmentNotFound is a pseudo-status for an application whose environment no longer exists.
+	applicationStatusEnvironmentNotFound = "EnvironmentNotFound"
+)
+
+// statusApplicationDeletingFromEnvironment is like statusApplication, except that the environment having been
+// deleted out of band is reported as a terminal pseudo-status rather than the application not being found.
+func statusApplicationDeletingFromEnvironment(ctx context.Context, conn *m2.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := findApplicationVersionByTwoPartKey(ctx, conn, id, version)
+		output, err := findApplicationByID(ctx, conn, id)
+
+		if isEnvironmentNotFoundError(err) {
+			return &m2.GetApplicationOutput{ApplicationId: aws.String(id)}, applicationStatusEnvironmentNotFound, nil
+		}
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -478,50 +2019,107 @@ func statusApplicationVersion(ctx context.Context, conn *m2.Client, id string, v
 	}
 }
 
+// isEnvironmentNotFoundError returns whether the error is a ResourceNotFoundException for an environment.
+func isEnvironmentNotFoundError(err error) bool {
+	var e *awstypes.ResourceNotFoundException
+	if errors.As(err, &e) {
+		return strings.EqualFold(aws.ToString(e.ResourceType), "environment")
+	}
+
+	return false
+}
+
+// statusApplicationVersion returns the status of an application version that is being created.
+// GetApplicationVersion is eventually consistent, so a new version may briefly not be found after UpdateApplication returns.
+// Not found is reported as the version not yet existing only within the propagation timeout of the first refresh,
+// after which the NotFound error is returned. This must not be used to wait for a version to be deleted.
+func statusApplicationVersion(ctx context.Context, conn *m2.Client, id string, version int32, propagationTimeout time.Duration) retry.StateRefreshFunc {
+	var start time.Time
+
+	return func() (interface{}, string, error) {
+		if start.IsZero() {
+			start = time.Now()
+		}
+
+		output, err := findApplicationVersionByTwoPartKey(ctx, conn, id, version)
+
+		if tfresource.NotFound(err) && time.Since(start) < propagationTimeout {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
 func waitApplicationCreated(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	stats := &waiterStats{}
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.ApplicationLifecycleCreating),
-		Target:  enum.Slice(awstypes.ApplicationLifecycleCreated, awstypes.ApplicationLifecycleAvailable),
-		Refresh: statusApplication(ctx, conn, id),
-		Timeout: timeout,
+		Pending:    enum.Slice(awstypes.ApplicationLifecycleCreating),
+		Target:     enum.Slice(awstypes.ApplicationLifecycleCreated, awstypes.ApplicationLifecycleAvailable),
+		Refresh:    stats.refresh(statusApplication(ctx, conn, id)),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*m2.GetApplicationOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+		stats.log(ctx, "application create", id, string(output.Status), err)
 
 		return output, err
 	}
 
+	stats.log(ctx, "application create", id, "", err)
+
 	return nil, err
 }
 
+// waitApplicationCreatedIfReady waits for the specified application to be created if waitForReady is set.
+// Otherwise the application is returned as is, and its status is reconciled on the next refresh.
+func waitApplicationCreatedIfReady(ctx context.Context, conn *m2.Client, id string, waitForReady bool, timeout time.Duration) (*m2.GetApplicationOutput, error) {
+	if !waitForReady {
+		return findApplicationByID(ctx, conn, id)
+	}
+
+	return waitApplicationCreated(ctx, conn, id, timeout)
+}
+
 func waitApplicationUpdated(ctx context.Context, conn *m2.Client, id string, version int32, timeout time.Duration) (*m2.GetApplicationVersionOutput, error) {
+	stats := &waiterStats{}
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.ApplicationVersionLifecycleCreating),
-		Target:  enum.Slice(awstypes.ApplicationVersionLifecycleAvailable),
-		Refresh: statusApplicationVersion(ctx, conn, id, version),
-		Timeout: timeout,
+		Pending:    enum.Slice(awstypes.ApplicationVersionLifecycleCreating),
+		Target:     enum.Slice(awstypes.ApplicationVersionLifecycleAvailable),
+		Refresh:    stats.refresh(statusApplicationVersion(ctx, conn, id, version, applicationVersionPropagationTimeout)),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*m2.GetApplicationVersionOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+		stats.log(ctx, "application update", id, string(output.Status), err)
 
 		return output, err
 	}
 
+	stats.log(ctx, "application update", id, "", err)
+
 	return nil, err
 }
 
 func waitApplicationDeleted(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.ApplicationLifecycleDeleting, awstypes.ApplicationLifecycleDeletingFromEnvironment),
-		Target:  []string{},
-		Refresh: statusApplication(ctx, conn, id),
-		Timeout: timeout,
+		Pending:    enum.Slice(awstypes.ApplicationLifecycleDeleting, awstypes.ApplicationLifecycleDeletingFromEnvironment),
+		Target:     []string{},
+		Refresh:    statusApplication(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -538,8 +2136,8 @@ func waitApplicationDeleted(ctx context.Context, conn *m2.Client, id string, tim
 func waitApplicationDeletedFromEnvironment(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetApplicationOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.ApplicationLifecycleDeletingFromEnvironment),
-		Target:  enum.Slice(awstypes.ApplicationLifecycleAvailable),
-		Refresh: statusApplication(ctx, conn, id),
+		Target:  append(enum.Slice(awstypes.ApplicationLifecycleAvailable), applicationStatusEnvironmentNotFound),
+		Refresh: statusApplicationDeletingFromEnvironment(ctx, conn, id),
 		Timeout: timeout,
 	}
 
@@ -595,21 +2193,72 @@ func waitApplicationRunning(ctx context.Context, conn *m2.Client, id string, tim
 }
 
 type applicationResourceModel struct {
-	ApplicationID  types.String                                     `tfsdk:"application_id"`
-	ApplicationARN types.String                                     `tfsdk:"arn"`
-	CurrentVersion types.Int64                                      `tfsdk:"current_version"`
-	Definition     fwtypes.ListNestedObjectValueOf[definitionModel] `tfsdk:"definition"`
-	Description    types.String                                     `tfsdk:"description"`
-	EngineType     fwtypes.StringEnum[awstypes.EngineType]          `tfsdk:"engine_type"`
-	ID             types.String                                     `tfsdk:"id"`
-	KmsKeyID       types.String                                     `tfsdk:"kms_key_id"`
-	Name           types.String                                     `tfsdk:"name"`
-	RoleARN        fwtypes.ARN                                      `tfsdk:"role_arn"`
-	Tags           types.Map                                        `tfsdk:"tags"`
-	TagsAll        types.Map                                        `tfsdk:"tags_all"`
-	Timeouts       timeouts.Value                                   `tfsdk:"timeouts"`
+	AllowCrossAccount            types.Bool                                                      `tfsdk:"allow_cross_account"`
+	ApplicationID                types.String                                                    `tfsdk:"application_id"`
+	ApplicationARN               types.String                                                    `tfsdk:"arn"`
+	CreationTime                 timetypes.RFC3339                                               `tfsdk:"creation_time"`
+	CurrentVersion               types.Int64                                                     `tfsdk:"current_version"`
+	DeletionProtection           types.Bool                                                      `tfsdk:"deletion_protection"`
+	DeployedEnvironmentIDs       fwtypes.ListValueOf[types.String]                               `tfsdk:"deployed_environment_ids"`
+	Definition                   fwtypes.ListNestedObjectValueOf[definitionModel]                `tfsdk:"definition"`
+	Description                  types.String                                                    `tfsdk:"description"`
+	EngineType                   fwtypes.StringEnum[awstypes.EngineType]                         `tfsdk:"engine_type"`
+	EnvironmentID                types.String                                                    `tfsdk:"environment_id"`
+	ID                           types.String                                                    `tfsdk:"id"`
+	KmsKeyID                     types.String                                                    `tfsdk:"kms_key_id"`
+	LogGroups                    fwtypes.ListNestedObjectValueOf[logGroupSummaryModel]           `tfsdk:"log_groups"`
+	Name                         types.String                                                    `tfsdk:"name"`
+	RoleARN                      fwtypes.ARN                                                     `tfsdk:"role_arn"`
+	SkipNameUniquenessCheck      types.Bool                                                      `tfsdk:"skip_name_uniqueness_check"`
+	StatusReason                 types.String                                                    `tfsdk:"status_reason"`
+	StopBeforeDelete             types.Bool                                                      `tfsdk:"stop_before_delete"`
+	Tags                         types.Map                                                       `tfsdk:"tags"`
+	TagsAll                      types.Map                                                       `tfsdk:"tags_all"`
+	Timeouts                     timeouts.Value                                                  `tfsdk:"timeouts"`
+	TriggerVersionReplacement    fwtypes.MapValueOf[types.String]                                `tfsdk:"trigger_version_replacement"`
+	ValidateDefinitionS3Location types.Bool                                                      `tfsdk:"validate_definition_s3_location"`
+	ValidateRoleTrustPolicy      types.Bool                                                      `tfsdk:"validate_role_trust_policy"`
+	Versions                     fwtypes.ListNestedObjectValueOf[applicationVersionSummaryModel] `tfsdk:"versions"`
+	WaitForReady                 types.Bool                                                      `tfsdk:"wait_for_ready"`
+}
+
+// parseApplicationImportID parses an application import ID of the form "application-id[,s3-location]".
+// The S3 location can pin an object version in the form returned by definition.normalized_s3_location.
+func parseApplicationImportID(id string) (string, string, string, error) {
+	applicationID, s3Location, found := strings.Cut(id, applicationImportIDSeparator)
+
+	if !found {
+		return id, "", "", nil
+	}
+
+	// The S3 location must be an S3 URI, so an empty part or an extra separator is rejected.
+	if applicationID == "" || !strings.HasPrefix(strings.ToLower(s3Location), "s3://") || len(s3Location) == len("s3://") {
+		return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION-ID or APPLICATION-ID%[2]sS3-LOCATION", id, applicationImportIDSeparator)
+	}
+
+	s3Location, s3ObjectVersion, found := strings.Cut(s3Location, "?versionId=")
+
+	if !found {
+		return applicationID, s3Location, "", nil
+	}
+
+	s3ObjectVersion, err := url.QueryUnescape(s3ObjectVersion)
+
+	if err != nil {
+		return "", "", "", fmt.Errorf("parsing S3 object version in ID (%s): %w", id, err)
+	}
+
+	if s3ObjectVersion == "" {
+		return "", "", "", fmt.Errorf("unexpected format for ID (%s), expected a non-empty S3 object version", id)
+	}
+
+	return applicationID, s3Location, s3ObjectVersion, nil
 }
 
+const (
+	applicationImportIDSeparator = ","
+)
+
 func (model *applicationResourceModel) InitFromID() error {
 	model.ApplicationID = model.ID
 
@@ -620,23 +2269,434 @@ func (model *applicationResourceModel) setID() {
 	model.ID = model.ApplicationID
 }
 
+const (
+	// definitionContentMaxLength is the maximum length, in bytes, of inline definition content.
+	definitionContentMaxLength = 65000
+)
+
+// definitionContentSizeWarningValidator warns when inline definition content is within 5% of the API's size limit.
+// Content is typically assembled with templatefile() and so can grow past the limit unnoticed.
+func definitionContentSizeWarningValidator() validator.String {
+	return definitionContentSizeValidator{}
+}
+
+type definitionContentSizeValidator struct{}
+
+func (v definitionContentSizeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value should be less than 95%% of %d bytes", definitionContentMaxLength)
+}
+
+func (v definitionContentSizeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v definitionContentSizeValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Count bytes, not characters, as that's what the API limits.
+	if n := len(request.ConfigValue.ValueString()); n <= definitionContentMaxLength && n*100 >= definitionContentMaxLength*95 {
+		response.Diagnostics.AddAttributeWarning(
+			request.Path,
+			"Definition Content Near Size Limit",
+			fmt.Sprintf("Definition content is %d bytes, within 5%% of the %d byte limit.", n, definitionContentMaxLength),
+		)
+	}
+}
+
+// definitionContentMaxLengthValidator validates that inline definition content is within the API's size limit,
+// unless it's staged to S3 by setting the sibling staging_bucket attribute.
+func definitionContentMaxLengthValidator() validator.String {
+	return definitionContentMaxLengthValidatorImpl{}
+}
+
+type definitionContentMaxLengthValidatorImpl struct{}
+
+func (v definitionContentMaxLengthValidatorImpl) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %d bytes unless staging_bucket is set", definitionContentMaxLength)
+}
+
+func (v definitionContentMaxLengthValidatorImpl) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v definitionContentMaxLengthValidatorImpl) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if n := len(request.ConfigValue.ValueString()); n <= definitionContentMaxLength {
+		return
+	}
+
+	var stagingBucket types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, request.Path.ParentPath().AtName("staging_bucket"), &stagingBucket)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if stagingBucket.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Definition Content Too Large",
+			fmt.Sprintf("Definition content is %d bytes, more than the %d byte limit. Set staging_bucket to stage the content to S3, or use s3_location.", len(request.ConfigValue.ValueString()), definitionContentMaxLength),
+		)
+	}
+}
+
+// definitionBlockCountValidator validates that exactly one definition block is configured.
+// It replaces listvalidator.IsRequired and listvalidator.SizeAtMost(1), whose generic messages don't say how to fix the configuration.
+func definitionBlockCountValidator() validator.List {
+	return definitionBlockCountValidatorImpl{}
+}
+
+type definitionBlockCountValidatorImpl struct{}
+
+func (v definitionBlockCountValidatorImpl) Description(_ context.Context) string {
+	return "exactly one definition block must be configured"
+}
+
+func (v definitionBlockCountValidatorImpl) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v definitionBlockCountValidatorImpl) ValidateList(ctx context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	switch n := len(request.ConfigValue.Elements()); {
+	case n == 0:
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Missing Definition Block",
+			"A definition block is required. Configure one definition block setting content, content_base64, content_file, s3_location, or s3_bucket and s3_key.",
+		)
+	case n > 1:
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Too Many Definition Blocks",
+			fmt.Sprintf("Exactly one definition block can be configured, got %d. Each application version has a single definition; remove the extra blocks.", n),
+		)
+	}
+}
+
+// definitionUseStateWhenUnchanged plans the prior definition when none of its configurable attributes have changed.
+// This keeps the definition's representation in state, including computed attributes, stable across updates that
+// don't touch it, such as tags-only changes. Computed attributes are still recalculated by ModifyPlan afterwards.
+func definitionUseStateWhenUnchanged() planmodifier.List {
+	return definitionUseStateWhenUnchangedModifier{}
+}
+
+type definitionUseStateWhenUnchangedModifier struct{}
+
+func (m definitionUseStateWhenUnchangedModifier) Description(_ context.Context) string {
+	return "uses the prior definition if its configuration hasn't changed"
+}
+
+func (m definitionUseStateWhenUnchangedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m definitionUseStateWhenUnchangedModifier) PlanModifyList(ctx context.Context, request planmodifier.ListRequest, response *planmodifier.ListResponse) {
+	// Nothing to preserve on create or destroy.
+	if request.StateValue.IsNull() || request.PlanValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if request.PlanValue.IsUnknown() {
+		response.PlanValue = request.StateValue
+
+		return
+	}
+
+	definitionType := fwtypes.NewListNestedObjectTypeOf[definitionModel](ctx)
+	toPtr := func(v types.List) (*definitionModel, diag.Diagnostics) {
+		value, diags := definitionType.ValueFromList(ctx, v)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return value.(fwtypes.ListNestedObjectValueOf[definitionModel]).ToPtr(ctx)
+	}
+
+	old, diags := toPtr(request.StateValue)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	new, diags := toPtr(request.PlanValue)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if old == nil || new == nil {
+		return
+	}
+
+	if new.Content.Equal(old.Content) &&
+		new.ContentBase64.Equal(old.ContentBase64) &&
+		new.ContentFile.Equal(old.ContentFile) &&
+		new.S3Bucket.Equal(old.S3Bucket) &&
+		new.S3Key.Equal(old.S3Key) &&
+		new.S3Location.Equal(old.S3Location) &&
+		new.S3ObjectVersion.Equal(old.S3ObjectVersion) &&
+		new.StagingBucket.Equal(old.StagingBucket) {
+		response.PlanValue = request.StateValue
+	}
+}
+
+// flattenDefinitionStagedS3Location returns the S3 location that the specified application's inline definition content is staged to.
+// Only content larger than the API's inline limit is staged. The key is derived from the content, so a changed definition is staged
+// to a new object and the previous version's object can be deleted once the new version is created.
+func flattenDefinitionStagedS3Location(name string, definitionData *definitionModel) types.String {
+	if definitionData.StagingBucket.IsNull() || definitionData.Content.IsNull() {
+		return types.StringNull()
+	}
+
+	content := definitionData.Content.ValueString()
+	if len(content) <= definitionContentMaxLength {
+		return types.StringNull()
+	}
+
+	return types.StringValue(fmt.Sprintf("s3://%s/%s/definition-%x.json", definitionData.StagingBucket.ValueString(), name, sha256.Sum256([]byte(content))))
+}
+
+// stageDefinitionContent uploads the specified definition's inline content to its staged S3 location.
+func stageDefinitionContent(ctx context.Context, conn *s3.Client, definitionData *definitionModel) error {
+	bucket, key, err := definitionS3LocationBucketAndKey(definitionData.StagedS3Location.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		Body:        strings.NewReader(definitionData.Content.ValueString()),
+		ContentType: aws.String("application/json"),
+	})
+
+	if err != nil {
+		return fmt.Errorf("staging definition content to S3 (%s): %w", definitionData.StagedS3Location.ValueString(), err)
+	}
+
+	return nil
+}
+
+// deleteReplacedStagedDefinitionContent deletes the previous version's staged definition content once a new version is created.
+// Failing to delete it is a warning, as the new version has already been created.
+func deleteReplacedStagedDefinitionContent(ctx context.Context, conn *s3.Client, old, new applicationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldDefinitionData, d := old.Definition.ToPtr(ctx)
+	diags.Append(d...)
+	newDefinitionData, d := new.Definition.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || oldDefinitionData == nil || oldDefinitionData.StagedS3Location.IsNull() {
+		return diags
+	}
+
+	if newDefinitionData != nil && newDefinitionData.StagedS3Location.Equal(oldDefinitionData.StagedS3Location) {
+		return diags
+	}
+
+	if err := deleteStagedDefinitionContent(ctx, conn, oldDefinitionData.StagedS3Location.ValueString()); err != nil {
+		diags.AddWarning("Staged Definition Content Not Deleted", err.Error())
+	}
+
+	return diags
+}
+
+// deleteStagedDefinitionContent deletes the staged definition content at the specified S3 location.
+// Mainframe Modernization reads the definition when an application version is created, so the object isn't needed afterwards.
+func deleteStagedDefinitionContent(ctx context.Context, conn *s3.Client, stagedS3Location string) error {
+	bucket, key, err := definitionS3LocationBucketAndKey(stagedS3Location)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	// DeleteObject already succeeds for a key that doesn't exist.
+	// A staging bucket that no longer exists means there's nothing to clean up.
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting staged definition content from S3 (%s): %w", stagedS3Location, err)
+	}
+
+	return nil
+}
+
+type applicationVersionSummaryModel struct {
+	ApplicationVersion types.Int64                                              `tfsdk:"application_version"`
+	CreationTime       timetypes.RFC3339                                        `tfsdk:"creation_time"`
+	Status             fwtypes.StringEnum[awstypes.ApplicationVersionLifecycle] `tfsdk:"status"`
+}
+
+type logGroupSummaryModel struct {
+	LogGroupName types.String `tfsdk:"log_group_name"`
+	LogType      types.String `tfsdk:"log_type"`
+}
+
 type definitionModel struct {
-	Content    types.String `tfsdk:"content"`
-	S3Location types.String `tfsdk:"s3_location"`
+	Content              types.String `tfsdk:"content"`
+	ContentBase64        types.String `tfsdk:"content_base64"`
+	ContentFile          types.String `tfsdk:"content_file"`
+	ContentFileHash      types.String `tfsdk:"content_file_hash"`
+	NormalizedS3Location types.String `tfsdk:"normalized_s3_location"`
+	S3Bucket             types.String `tfsdk:"s3_bucket"`
+	S3Key                types.String `tfsdk:"s3_key"`
+	S3Location           types.String `tfsdk:"s3_location"`
+	S3ObjectVersion      types.String `tfsdk:"s3_object_version"`
+	StagedS3Location     types.String `tfsdk:"staged_s3_location"`
+	StagingBucket        types.String `tfsdk:"staging_bucket"`
 }
 
-func expandDefinition(definitionData *definitionModel) awstypes.Definition {
+func expandDefinition(definitionData *definitionModel) (awstypes.Definition, error) {
+	if !definitionData.StagedS3Location.IsNull() && !definitionData.StagedS3Location.IsUnknown() {
+		return &awstypes.DefinitionMemberS3Location{
+			Value: definitionData.StagedS3Location.ValueString(),
+		}, nil
+	}
+
 	if !definitionData.Content.IsNull() {
 		return &awstypes.DefinitionMemberContent{
 			Value: definitionData.Content.ValueString(),
+		}, nil
+	}
+
+	if !definitionData.ContentBase64.IsNull() {
+		content, err := decodeDefinitionContentBase64(definitionData.ContentBase64.ValueString())
+
+		if err != nil {
+			return nil, err
 		}
+
+		return &awstypes.DefinitionMemberContent{
+			Value: content,
+		}, nil
 	}
 
-	if !definitionData.S3Location.IsNull() {
-		return &awstypes.DefinitionMemberS3Location{
-			Value: definitionData.S3Location.ValueString(),
+	if !definitionData.ContentFile.IsNull() {
+		content, err := readDefinitionContentFile(definitionData.ContentFile.ValueString())
+
+		if err != nil {
+			return nil, err
 		}
+
+		return &awstypes.DefinitionMemberContent{
+			Value: content,
+		}, nil
 	}
 
-	return nil
+	if s3Location := definitionS3Location(definitionData); !s3Location.IsNull() {
+		return &awstypes.DefinitionMemberS3Location{
+			Value: normalizeDefinitionS3Location(s3Location.ValueString(), definitionData.S3ObjectVersion.ValueString()),
+		}, nil
+	}
+
+	// Configuration validation should make this unreachable.
+	return nil, errors.New("definition must have one of content, content_base64, content_file, s3_location or s3_bucket set")
+}
+
+// decodeDefinitionContentBase64 returns the decoded contents of the specified base64-encoded definition content.
+func decodeDefinitionContentBase64(s string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+
+	if err != nil {
+		return "", fmt.Errorf("decoding definition content: %w", err)
+	}
+
+	if n := len(b); n == 0 || n > definitionContentMaxLength {
+		return "", fmt.Errorf("decoded definition content is %d bytes, must be between 1 and %d bytes", n, definitionContentMaxLength)
+	}
+
+	return string(b), nil
+}
+
+// readDefinitionContentFile returns the contents of the specified definition content file.
+func readDefinitionContentFile(name string) (string, error) {
+	b, err := os.ReadFile(name)
+
+	if err != nil {
+		return "", fmt.Errorf("reading definition content file: %w", err)
+	}
+
+	if n := len(b); n == 0 || n > definitionContentMaxLength {
+		return "", fmt.Errorf("definition content file (%s) is %d bytes, must be between 1 and %d bytes", name, n, definitionContentMaxLength)
+	}
+
+	return string(b), nil
+}
+
+// definitionContentFileHash returns the hex-encoded SHA-256 hash of the specified definition content file's contents.
+// Only the hash is stored in state, not the contents.
+func definitionContentFileHash(name string) (string, error) {
+	content, err := readDefinitionContentFile(name)
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// flattenDefinitionNormalizedS3Location returns the S3 location that is sent to the API for the specified definition.
+// The API doesn't return the definition's S3 location, only the resolved content, so this can't be read back.
+func flattenDefinitionNormalizedS3Location(definitionData *definitionModel) types.String {
+	s3Location := definitionS3Location(definitionData)
+
+	if s3Location.IsNull() || s3Location.IsUnknown() {
+		return s3Location
+	}
+
+	return types.StringValue(normalizeDefinitionS3Location(s3Location.ValueString(), definitionData.S3ObjectVersion.ValueString()))
+}
+
+// definitionS3Location returns the S3 location of the specified definition, either as configured or assembled from its bucket and key.
+// A leading slash in the key is ignored, so that it isn't mistaken for an empty path segment.
+func definitionS3Location(definitionData *definitionModel) types.String {
+	if !definitionData.S3Location.IsNull() {
+		return definitionData.S3Location
+	}
+
+	if definitionData.S3Bucket.IsNull() || definitionData.S3Key.IsNull() {
+		return types.StringNull()
+	}
+
+	if definitionData.S3Bucket.IsUnknown() || definitionData.S3Key.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	return types.StringValue("s3://" + definitionData.S3Bucket.ValueString() + "/" + strings.TrimLeft(definitionData.S3Key.ValueString(), "/"))
+}
+
+// normalizeDefinitionS3Location returns the canonical form of a definition S3 location.
+// The URI scheme is lowercased and any object version is pinned in the URI.
+func normalizeDefinitionS3Location(s3Location, s3ObjectVersion string) string {
+	const scheme = "s3://"
+
+	if len(s3Location) >= len(scheme) && strings.EqualFold(s3Location[:len(scheme)], scheme) {
+		s3Location = scheme + s3Location[len(scheme):]
+	}
+
+	// The API has no separate field for the object version, so pin it in the S3 URI.
+	if s3ObjectVersion != "" {
+		s3Location = s3Location + "?versionId=" + url.QueryEscape(s3ObjectVersion)
+	}
+
+	return s3Location
 }
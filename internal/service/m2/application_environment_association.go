@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Application Environment Association")
+func newApplicationEnvironmentAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &applicationEnvironmentAssociationResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type applicationEnvironmentAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (*applicationEnvironmentAssociationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_m2_application_environment_association"
+}
+
+func (r *applicationEnvironmentAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_version": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *applicationEnvironmentAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationEnvironmentAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	applicationVersion, err := expandApplicationVersion(data.ApplicationVersion)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Mainframe Modernization Application Environment Association", err.Error())
+
+		return
+	}
+
+	applicationID, environmentID := data.ApplicationID.ValueString(), data.EnvironmentID.ValueString()
+	deployment, err := associateApplicationWithEnvironment(ctx, conn, applicationID, environmentID, applicationVersion, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Mainframe Modernization Application Environment Association (%s)", applicationID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CreationTime = timetypes.NewRFC3339TimePointerValue(deployment.CreationTime)
+	data.DeploymentID = fwflex.StringToFramework(ctx, deployment.DeploymentId)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *applicationEnvironmentAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationEnvironmentAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	output, err := findApplicationEnvironmentAssociationByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.EnvironmentID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application Environment Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ApplicationVersion = flattenApplicationVersion(aws.ToInt32(output.ApplicationVersion))
+	data.CreationTime = timetypes.NewRFC3339TimePointerValue(output.CreationTime)
+	data.DeploymentID = fwflex.StringToFramework(ctx, output.DeploymentId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationEnvironmentAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationEnvironmentAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	if err := disassociateApplicationFromEnvironment(ctx, conn, data.ApplicationID.ValueString(), data.EnvironmentID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Mainframe Modernization Application Environment Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// associateApplicationWithEnvironment deploys the specified application version to the environment, waiting for the deployment to succeed.
+// If that version is already deployed to the environment, its deployment is returned and no new deployment is created.
+func associateApplicationWithEnvironment(ctx context.Context, conn *m2.Client, applicationID, environmentID string, applicationVersion int32, timeout time.Duration) (*awstypes.DeploymentSummary, error) {
+	existing, err := findApplicationEnvironmentAssociationByTwoPartKey(ctx, conn, applicationID, environmentID)
+
+	switch {
+	case err == nil && aws.ToInt32(existing.ApplicationVersion) == applicationVersion:
+		return existing, nil
+	case err != nil && !tfresource.NotFound(err):
+		return nil, err
+	}
+
+	input := &m2.CreateDeploymentInput{
+		ApplicationId:      aws.String(applicationID),
+		ApplicationVersion: aws.Int32(applicationVersion),
+		ClientToken:        aws.String(sdkid.UniqueId()),
+		EnvironmentId:      aws.String(environmentID),
+	}
+
+	_, deployment, err := createDeployment(ctx, conn, input, waitDeploymentCreated, timeout)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &awstypes.DeploymentSummary{
+		ApplicationId:      deployment.ApplicationId,
+		ApplicationVersion: deployment.ApplicationVersion,
+		CreationTime:       deployment.CreationTime,
+		DeploymentId:       deployment.DeploymentId,
+		EnvironmentId:      deployment.EnvironmentId,
+		Status:             deployment.Status,
+		StatusReason:       deployment.StatusReason,
+	}, nil
+}
+
+// disassociateApplicationFromEnvironment removes the application from the environment, waiting for it to be removed.
+// An application or environment that no longer exists is not an error.
+func disassociateApplicationFromEnvironment(ctx context.Context, conn *m2.Client, applicationID, environmentID string, timeout time.Duration) error {
+	_, err := conn.DeleteApplicationFromEnvironment(ctx, &m2.DeleteApplicationFromEnvironmentInput{
+		ApplicationId: aws.String(applicationID),
+		EnvironmentId: aws.String(environmentID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = waitApplicationDeletedFromEnvironment(ctx, conn, applicationID, timeout)
+
+	return err
+}
+
+// findApplicationEnvironmentAssociationByTwoPartKey returns the successful deployment of the version of the application
+// that is deployed to the specified environment.
+func findApplicationEnvironmentAssociationByTwoPartKey(ctx context.Context, conn *m2.Client, applicationID, environmentID string) (*awstypes.DeploymentSummary, error) {
+	application, err := findApplicationByID(ctx, conn, applicationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToString(application.EnvironmentId) != environmentID || application.DeployedVersion == nil {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("Mainframe Modernization Application (%s) is not deployed to Environment (%s)", applicationID, environmentID),
+		}
+	}
+
+	deployments, err := findDeployments(ctx, conn, &m2.ListDeploymentsInput{
+		ApplicationId: aws.String(applicationID),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Many deployments of the same version may have been made, so the most recent one is returned.
+	var output *awstypes.DeploymentSummary
+	for _, deployment := range deployments {
+		if aws.ToString(deployment.EnvironmentId) != environmentID || deployment.Status != awstypes.DeploymentLifecycleSucceeded {
+			continue
+		}
+
+		if aws.ToInt32(deployment.ApplicationVersion) != aws.ToInt32(application.DeployedVersion.ApplicationVersion) {
+			continue
+		}
+
+		if output == nil || aws.ToTime(deployment.CreationTime).After(aws.ToTime(output.CreationTime)) {
+			output = &deployment
+		}
+	}
+
+	if output == nil {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("Mainframe Modernization Application (%s) has no successful deployment to Environment (%s)", applicationID, environmentID),
+		}
+	}
+
+	return output, nil
+}
+
+type applicationEnvironmentAssociationResourceModel struct {
+	ApplicationID      types.String      `tfsdk:"application_id"`
+	ApplicationVersion types.Int64       `tfsdk:"application_version"`
+	CreationTime       timetypes.RFC3339 `tfsdk:"creation_time"`
+	DeploymentID       types.String      `tfsdk:"deployment_id"`
+	EnvironmentID      types.String      `tfsdk:"environment_id"`
+	ID                 types.String      `tfsdk:"id"`
+	Timeouts           timeouts.Value    `tfsdk:"timeouts"`
+}
+
+const (
+	applicationEnvironmentAssociationResourceIDPartCount = 2
+)
+
+func (data *applicationEnvironmentAssociationResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, applicationEnvironmentAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ApplicationID = types.StringValue(parts[0])
+	data.EnvironmentID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *applicationEnvironmentAssociationResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ApplicationID.ValueString(), data.EnvironmentID.ValueString()}, applicationEnvironmentAssociationResourceIDPartCount, false)))
+}
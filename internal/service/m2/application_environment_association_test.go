@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccM2ApplicationEnvironmentAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application_environment_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationEnvironmentAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationEnvironmentAssociationConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationEnvironmentAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, "aws_m2_application.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "application_version", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationTime),
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_id", "aws_m2_environment.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccM2ApplicationEnvironmentAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application_environment_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationEnvironmentAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationEnvironmentAssociationConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationEnvironmentAssociationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfm2.ResourceApplicationEnvironmentAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAssociateApplicationWithEnvironment(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses        []mockResponse
+		wantDeploymentID string
+		wantRequests     int
+		wantCreate       bool
+	}{
+		"already associated": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app","environmentId":"env","deployedVersion":{"applicationVersion":1,"status":"Succeeded"}}`},
+				{body: `{"deployments":[{"applicationId":"app","applicationVersion":1,"creationTime":1700000000,"deploymentId":"dep-1","environmentId":"env","status":"Succeeded"}]}`},
+			},
+			wantDeploymentID: "dep-1",
+			wantRequests:     2,
+		},
+		"not associated": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app","status":"Available"}`},
+				{body: `{"deploymentId":"dep-2"}`},
+				{body: `{"applicationId":"app","applicationVersion":1,"creationTime":1700000000,"deploymentId":"dep-2","environmentId":"env","status":"Succeeded"}`},
+			},
+			wantDeploymentID: "dep-2",
+			wantRequests:     3,
+			wantCreate:       true,
+		},
+		"other version associated": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app","environmentId":"env","deployedVersion":{"applicationVersion":2,"status":"Succeeded"}}`},
+				{body: `{"deployments":[{"applicationId":"app","applicationVersion":2,"creationTime":1700000000,"deploymentId":"dep-1","environmentId":"env","status":"Succeeded"}]}`},
+				{body: `{"deploymentId":"dep-2"}`},
+				{body: `{"applicationId":"app","applicationVersion":1,"creationTime":1700000000,"deploymentId":"dep-2","environmentId":"env","status":"Succeeded"}`},
+			},
+			wantDeploymentID: "dep-2",
+			wantRequests:     4,
+			wantCreate:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			output, err := tfm2.AssociateApplicationWithEnvironment(context.Background(), conn, "app", "env", 1, 30*time.Minute)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToString(output.DeploymentId), testCase.wantDeploymentID; got != want {
+				t.Errorf("DeploymentId = %q, want %q", got, want)
+			}
+
+			if got, want := httpClient.requestCount(), testCase.wantRequests; got != want {
+				t.Fatalf("requests = %d, want %d", got, want)
+			}
+
+			var created bool
+			for _, request := range httpClient.requests {
+				if request.Method == http.MethodPost && strings.HasSuffix(request.URL.Path, "/deployments") {
+					created = true
+				}
+			}
+
+			if got, want := created, testCase.wantCreate; got != want {
+				t.Errorf("CreateDeployment called = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestDisassociateApplicationFromEnvironment(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses    []mockResponse
+		wantRequests int
+	}{
+		"associated": {
+			responses: []mockResponse{
+				{body: `{}`},
+				{body: `{"applicationId":"app","status":"Available"}`},
+			},
+			wantRequests: 2,
+		},
+		"already disassociated": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "application not found"),
+			},
+			wantRequests: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			if err := tfm2.DisassociateApplicationFromEnvironment(context.Background(), conn, "app", "env", 30*time.Minute); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := httpClient.requestCount(), testCase.wantRequests; got != want {
+				t.Fatalf("requests = %d, want %d", got, want)
+			}
+
+			if got, want := httpClient.requests[0].Method, http.MethodDelete; got != want {
+				t.Errorf("method = %s, want %s", got, want)
+			}
+
+			if got, want := httpClient.requests[0].URL.Path, "/applications/app/environment/env"; got != want {
+				t.Errorf("path = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestFindApplicationEnvironmentAssociationByTwoPartKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses        []mockResponse
+		wantNotFound     bool
+		wantDeploymentID string
+	}{
+		"latest successful deployment": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app","environmentId":"env","deployedVersion":{"applicationVersion":1,"status":"Succeeded"}}`},
+				{body: `{"deployments":[` +
+					`{"applicationId":"app","applicationVersion":1,"creationTime":1700000000,"deploymentId":"dep-1","environmentId":"env","status":"Succeeded"},` +
+					`{"applicationId":"app","applicationVersion":1,"creationTime":1700000200,"deploymentId":"dep-3","environmentId":"env","status":"Succeeded"},` +
+					`{"applicationId":"app","applicationVersion":1,"creationTime":1700000300,"deploymentId":"dep-4","environmentId":"env","status":"Failed"},` +
+					`{"applicationId":"app","applicationVersion":1,"creationTime":1700000400,"deploymentId":"dep-5","environmentId":"other","status":"Succeeded"},` +
+					`{"applicationId":"app","applicationVersion":2,"creationTime":1700000500,"deploymentId":"dep-6","environmentId":"env","status":"Succeeded"}` +
+					`]}`},
+			},
+			wantDeploymentID: "dep-3",
+		},
+		"other environment": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app","environmentId":"other","deployedVersion":{"applicationVersion":1,"status":"Succeeded"}}`},
+			},
+			wantNotFound: true,
+		},
+		"not deployed": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app","status":"Available"}`},
+			},
+			wantNotFound: true,
+		},
+		"no successful deployment": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app","environmentId":"env","deployedVersion":{"applicationVersion":1,"status":"Failed"}}`},
+				{body: `{"deployments":[{"applicationId":"app","applicationVersion":1,"creationTime":1700000000,"deploymentId":"dep-1","environmentId":"env","status":"Failed"}]}`},
+			},
+			wantNotFound: true,
+		},
+		"application not found": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "application not found"),
+			},
+			wantNotFound: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, _ := newMockClient(t, testCase.responses...)
+
+			output, err := tfm2.FindApplicationEnvironmentAssociationByTwoPartKey(context.Background(), conn, "app", "env")
+
+			if testCase.wantNotFound {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected NotFound error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToString(output.DeploymentId), testCase.wantDeploymentID; got != want {
+				t.Errorf("DeploymentId = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func testAccCheckApplicationEnvironmentAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_m2_application_environment_association" {
+				continue
+			}
+
+			_, err := tfm2.FindApplicationEnvironmentAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["environment_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Mainframe Modernization Application Environment Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckApplicationEnvironmentAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
+
+		_, err := tfm2.FindApplicationEnvironmentAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["environment_id"])
+
+		return err
+	}
+}
+
+func testAccApplicationEnvironmentAssociationConfig_basic(rName string, applicationVersion int) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), testAccApplicationConfig_versioned(rName, "bluage", 1, 2), fmt.Sprintf(`
+resource "aws_m2_environment" "test" {
+  name          = %[1]q
+  engine_type   = "bluage"
+  instance_type = "M2.m5.large"
+
+  security_group_ids = [aws_security_group.test.id]
+  subnet_ids         = aws_subnet.test[*].id
+}
+
+data "aws_region" "current" {}
+
+resource "aws_vpc_endpoint" "secretsmanager" {
+  vpc_id            = aws_vpc.test.id
+  service_name      = "com.amazonaws.${data.aws_region.current.name}.secretsmanager"
+  vpc_endpoint_type = "Interface"
+
+  security_group_ids = [
+    aws_security_group.test.id,
+  ]
+  subnet_ids = aws_subnet.test[*].id
+
+  private_dns_enabled = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_m2_application_environment_association" "test" {
+  application_id      = aws_m2_application.test.id
+  application_version = %[2]d
+  environment_id      = aws_m2_environment.test.id
+  depends_on          = [aws_vpc_endpoint.secretsmanager]
+}
+`, rName, applicationVersion))
+}
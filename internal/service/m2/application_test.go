@@ -5,17 +5,48 @@ package m2_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"maps"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -45,15 +76,19 @@ func TestAccM2Application_basic(t *testing.T) {
 					testAccCheckApplicationExists(ctx, resourceName, &application),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrApplicationID),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "m2", regexache.MustCompile(`app/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationTime),
 					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct1),
 					resource.TestCheckResourceAttrSet(resourceName, "definition.0.content"),
 					resource.TestCheckNoResourceAttr(resourceName, "definition.0.s3_location"),
+					resource.TestCheckResourceAttr(resourceName, "deployed_environment_ids.#", acctest.Ct0),
+					resource.TestCheckNoResourceAttr(resourceName, "environment_id"),
 					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
 					resource.TestCheckResourceAttr(resourceName, "engine_type", "bluage"),
 					resource.TestCheckNoResourceAttr(resourceName, names.AttrKMSKeyID),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckNoResourceAttr(resourceName, names.AttrRoleARN),
+					resource.TestCheckNoResourceAttr(resourceName, "status_reason"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
 				),
 			},
@@ -118,6 +153,7 @@ func TestAccM2Application_tags(t *testing.T) {
 				Config: testAccApplicationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
 				),
@@ -131,6 +167,7 @@ func TestAccM2Application_tags(t *testing.T) {
 				Config: testAccApplicationConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
@@ -140,6 +177,7 @@ func TestAccM2Application_tags(t *testing.T) {
 				Config: testAccApplicationConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
 				),
@@ -148,6 +186,95 @@ func TestAccM2Application_tags(t *testing.T) {
 	})
 }
 
+func TestAccM2Application_tags_DefaultTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+	var application m2.GetApplicationOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1(acctest.CtProviderKey1, acctest.CtProviderValue1),
+					testAccApplicationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsAllPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", acctest.CtProviderValue1),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", acctest.CtValue1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccM2Application_tags_IgnoreTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+	var application m2.GetApplicationOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					// Simulate a tag applied outside of Terraform, for example by AWS or an organization policy.
+					testAccCheckApplicationUpdateTags(ctx, &application, nil, map[string]string{"ignorekey1": "ignorevalue1"}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"),
+					testAccApplicationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				),
+				PlanOnly: true,
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigIgnoreTagsKeys("ignorekey1"),
+					testAccApplicationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				),
+				PlanOnly: true,
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeyPrefixes1(acctest.CtProviderKey1, acctest.CtProviderValue1, "ignorekey"),
+					testAccApplicationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsAllPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", acctest.CtProviderValue1),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", acctest.CtValue1),
+					resource.TestCheckNoResourceAttr(resourceName, "tags_all.ignorekey1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccM2Application_full(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -216,6 +343,7 @@ func TestAccM2Application_update(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckApplicationExists(ctx, resourceName, &application),
 					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", acctest.Ct1),
 				),
 			},
 			{
@@ -223,6 +351,13 @@ func TestAccM2Application_update(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckApplicationExists(ctx, resourceName, &application),
 					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "versions.*", map[string]string{
+						"application_version": acctest.Ct1,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "versions.*", map[string]string{
+						"application_version": acctest.Ct2,
+					}),
 				),
 			},
 			{
@@ -234,97 +369,3974 @@ func TestAccM2Application_update(t *testing.T) {
 	})
 }
 
-func testAccCheckApplicationDestroy(ctx context.Context) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
-
-		for _, rs := range s.RootModule().Resources {
-			if rs.Type != "aws_m2_application" {
-				continue
-			}
-
-			_, err := tfm2.FindEnvironmentByID(ctx, conn, rs.Primary.ID)
-
-			if tfresource.NotFound(err) {
-				continue
-			}
-
-			if err != nil {
-				return err
-			}
-
-			return fmt.Errorf("Mainframe Modernization Application %s still exists", rs.Primary.ID)
-		}
-
-		return nil
+func TestAccM2Application_description(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
 	}
-}
-
-func testAccCheckApplicationExists(ctx context.Context, n string, v *m2.GetApplicationOutput) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("Not found: %s", n)
-		}
-
-		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
-
-		output, err := tfm2.FindApplicationByID(ctx, conn, rs.Primary.ID)
-
-		if err != nil {
-			return err
-		}
+	var application m2.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
 
-		*v = *output
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_description(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_description(rName, "description 2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+					resource.TestCheckResourceAttrWith(resourceName, "current_version", func(value string) error {
+						if got, want := value, strconv.Itoa(int(aws.ToInt32(application.LatestVersion.ApplicationVersion))); got != want {
+							return fmt.Errorf("current_version = %s, want latest version %s", got, want)
+						}
 
-		return nil
-	}
+						return nil
+					}),
+				),
+			},
+		},
+	})
 }
 
-func testAccApplicationPreCheck(ctx context.Context, t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
-
-	input := &m2.ListApplicationsInput{}
-	_, err := conn.ListApplications(ctx, input)
-
-	if acctest.PreCheckSkipError(err) {
-		t.Skipf("skipping acceptance testing: %s", err)
-	}
-	if err != nil {
-		t.Fatalf("unexpected PreCheck error: %s", err)
+func TestAccM2Application_deletionProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
 	}
-}
+	var application m2.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
 
-func testAccApplicationConfig_basic(rName, engineType string) string {
-	return testAccApplicationConfig_versioned(rName, engineType, 1, 1)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_deletionProtection(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", acctest.CtTrue),
+				),
+			},
+			{
+				Config:      testAccApplicationConfig_deletionProtection(rName, true),
+				Destroy:     true,
+				ExpectError: regexache.MustCompile(`deletion_protection is enabled`),
+			},
+			{
+				Config: testAccApplicationConfig_deletionProtection(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
+				),
+			},
+			{
+				Config:  testAccApplicationConfig_deletionProtection(rName, false),
+				Destroy: true,
+			},
+		},
+	})
 }
 
-func testAccApplicationConfig_versioned(rName, engineType string, version, versions int) string {
-	return fmt.Sprintf(`
-resource "aws_s3_bucket" "test" {
-  bucket = %[1]q
+func TestAccM2Application_triggerVersionReplacement(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var application m2.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_triggerVersionReplacement(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "trigger_version_replacement.build", "1"),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_triggerVersionReplacement(rName, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "trigger_version_replacement.build", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", acctest.Ct2),
+				),
+			},
+		},
+	})
 }
 
-resource "aws_s3_object" "test" {
-  count = %[4]d
+func TestAccM2Application_stagingBucket(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var application m2.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
 
-  bucket = aws_s3_bucket.test.id
-  key    = "v${count.index + 1}/PlanetsDemo-v${count.index + 1}.zip"
-  source = "test-fixtures/PlanetsDemo-v1.zip"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_stagingBucket(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.staging_bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestMatchResourceAttr(resourceName, "definition.0.staged_s3_location", regexache.MustCompile(fmt.Sprintf(`^s3://%[1]s/%[1]s/definition-[0-9a-f]{64}\.json$`, rName))),
+				),
+			},
+		},
+	})
 }
 
-resource "aws_m2_application" "test" {
-  name        = %[1]q
-  engine_type = %[2]q
-  definition {
-    content = templatefile("test-fixtures/application-definition.json", { s3_bucket = aws_s3_bucket.test.id, version = %[3]d })
-  }
+func TestAccM2Application_descriptionDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var application m2.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_description(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					testAccCheckApplicationUpdateDescription(ctx, &application, "changed out of band"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccApplicationConfig_description(rName, "description 1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccM2Application_multipleRegions(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var application m2.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+	resourceNameAlternate := "aws_m2_application.alternate"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The same definition must produce the same state in every Region, so that cross-Region modules don't diff.
+				Config: testAccApplicationConfig_multipleRegions(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.content", resourceNameAlternate, "definition.0.content"),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.normalized_s3_location", resourceNameAlternate, "definition.0.normalized_s3_location"),
+					resource.TestCheckResourceAttrPair(resourceName, "current_version", resourceNameAlternate, "current_version"),
+				),
+			},
+			{
+				Config:   testAccApplicationConfig_multipleRegions(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccM2Application_deployedEnvironmentIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var application m2.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, "bluage", 1, 1, true),
+			},
+			{
+				// The application is created before it's deployed, so refresh to pick up the deployment.
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "deployed_environment_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "deployed_environment_ids.0", "aws_m2_environment.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_id", "aws_m2_environment.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccM2Application_contentFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var application m2.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+	contentFile := testAccApplicationDefinitionContentFile(t, rName, 1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_contentFile(rName, contentFile),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct1),
+					resource.TestCheckNoResourceAttr(resourceName, "definition.0.content"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.content_file", contentFile),
+					resource.TestMatchResourceAttr(resourceName, "definition.0.content_file_hash", regexache.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccM2Application_contentBase64(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var application m2.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_contentBase64(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct1),
+					resource.TestCheckNoResourceAttr(resourceName, "definition.0.content"),
+					resource.TestCheckResourceAttrSet(resourceName, "definition.0.content_base64"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestDecodeDefinitionContentBase64(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		value       string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "valid",
+			value:    "eyJ0ZW1wbGF0ZS12ZXJzaW9uIjoiMi4wIn0=",
+			expected: `{"template-version":"2.0"}`,
+		},
+		{
+			name:        "invalid",
+			value:       "not base64!",
+			expectError: true,
+		},
+		{
+			name:        "too large",
+			value:       base64.StdEncoding.EncodeToString(make([]byte, 65001)),
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfm2.DecodeDefinitionContentBase64(testCase.value)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("content = %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestExpandDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		data        tfm2.DefinitionModel
+		expected    awstypes.Definition
+		expectError bool
+	}{
+		{
+			name: "content",
+			data: tfm2.DefinitionModel{
+				Content: types.StringValue("{}"),
+			},
+			expected: &awstypes.DefinitionMemberContent{Value: "{}"},
+		},
+		{
+			name: "content base64",
+			data: tfm2.DefinitionModel{
+				ContentBase64: types.StringValue("e30="),
+			},
+			expected: &awstypes.DefinitionMemberContent{Value: "{}"},
+		},
+		{
+			name: "s3 location",
+			data: tfm2.DefinitionModel{
+				S3Location: types.StringValue("s3://bucket/definition.json"),
+			},
+			expected: &awstypes.DefinitionMemberS3Location{Value: "s3://bucket/definition.json"},
+		},
+		{
+			name: "s3 bucket and key",
+			data: tfm2.DefinitionModel{
+				S3Bucket: types.StringValue("bucket"),
+				S3Key:    types.StringValue("path/definition.json"),
+			},
+			expected: &awstypes.DefinitionMemberS3Location{Value: "s3://bucket/path/definition.json"},
+		},
+		{
+			name: "s3 bucket and key with leading slash",
+			data: tfm2.DefinitionModel{
+				S3Bucket: types.StringValue("bucket"),
+				S3Key:    types.StringValue("/definition.json"),
+			},
+			expected: &awstypes.DefinitionMemberS3Location{Value: "s3://bucket/definition.json"},
+		},
+		{
+			name: "s3 bucket and key with object version",
+			data: tfm2.DefinitionModel{
+				S3Bucket:        types.StringValue("bucket"),
+				S3Key:           types.StringValue("definition.json"),
+				S3ObjectVersion: types.StringValue("v1"),
+			},
+			expected: &awstypes.DefinitionMemberS3Location{Value: "s3://bucket/definition.json?versionId=v1"},
+		},
+		{
+			name:        "empty",
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfm2.ExpandDefinition(&testCase.data)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if got != nil {
+					t.Errorf("definition = %#v, want nil", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreUnexported(awstypes.DefinitionMemberContent{}, awstypes.DefinitionMemberS3Location{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestApplicationDescriptionLength(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r, err := tfm2.ResourceApplication(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var schemaResponse fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+
+	attribute, ok := schemaResponse.Schema.Attributes[names.AttrDescription].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("description attribute is %T, want schema.StringAttribute", schemaResponse.Schema.Attributes[names.AttrDescription])
+	}
+
+	// The API limits descriptions to 500 characters regardless of engine type.
+	testCases := map[string]struct {
+		value       string
+		expectError bool
+	}{
+		"at limit": {
+			value: strings.Repeat("a", 500),
+		},
+		"over limit": {
+			value:       strings.Repeat("a", 501),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:        path.Root(names.AttrDescription),
+				ConfigValue: types.StringValue(testCase.value),
+			}
+			var response validator.StringResponse
+
+			for _, v := range attribute.StringValidators() {
+				v.ValidateString(ctx, request, &response)
+			}
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestApplicationSchemaDescriptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r, err := tfm2.ResourceApplication(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var schemaResponse fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+
+	// Attributes and blocks shared with other resources are described provider-wide.
+	shared := []string{names.AttrARN, names.AttrID, names.AttrTags, names.AttrTagsAll, names.AttrTimeouts}
+
+	for name, attribute := range schemaResponse.Schema.Attributes {
+		if !slices.Contains(shared, name) && attribute.GetDescription() == "" {
+			t.Errorf("attribute %q has no description", name)
+		}
+	}
+
+	for name, block := range schemaResponse.Schema.Blocks {
+		if slices.Contains(shared, name) {
+			continue
+		}
+
+		if block.GetDescription() == "" {
+			t.Errorf("block %q has no description", name)
+		}
+
+		for nestedName, attribute := range block.GetNestedObject().GetAttributes() {
+			if attribute.GetDescription() == "" {
+				t.Errorf("attribute %q of block %q has no description", nestedName, name)
+			}
+		}
+	}
+}
+
+func TestSetApplicationCreateOutputState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r, err := tfm2.ResourceApplication(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var schemaResponse fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResponse.Schema,
+	}
+
+	const arn = "arn:aws:m2:us-west-2:123456789012:app/app" //lintignore:AWSAT003,AWSAT005
+	diags := tfm2.SetApplicationCreateOutputState(ctx, &state, &m2.CreateApplicationOutput{
+		ApplicationArn:     aws.String(arn),
+		ApplicationId:      aws.String("app"),
+		ApplicationVersion: aws.Int32(1),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	var data tfm2.ApplicationResourceModel
+	if diags := state.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if got, want := data.ApplicationARN.ValueString(), arn; got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+
+	if got, want := data.ApplicationID.ValueString(), "app"; got != want {
+		t.Errorf("application_id = %q, want %q", got, want)
+	}
+
+	if got, want := data.ID.ValueString(), "app"; got != want {
+		t.Errorf("id = %q, want %q", got, want)
+	}
+
+	if got, want := data.CurrentVersion.ValueInt64(), int64(1); got != want {
+		t.Errorf("current_version = %d, want %d", got, want)
+	}
+}
+
+func TestApplicationResourceDeleteDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r, err := tfm2.ResourceApplication(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var schemaResponse fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResponse.Schema,
+	}
+	if diags := state.SetAttribute(ctx, path.Root(names.AttrID), "app"); diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+	if diags := state.SetAttribute(ctx, path.Root("deletion_protection"), true); diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	// The resource isn't configured, so any attempt to call DeleteApplication would panic.
+	response := fwresource.DeleteResponse{State: state}
+	r.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
+
+	if !response.Diagnostics.HasError() {
+		t.Fatal("expected error")
+	}
+
+	if got, want := response.Diagnostics.Errors()[0].Detail(), "deletion_protection is enabled"; !strings.Contains(got, want) {
+		t.Errorf("error = %q, want to contain %q", got, want)
+	}
+}
+
+func TestApplicationVersionConversion(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []int32{math.MinInt32, -1, 0, 1, math.MaxInt32} {
+		got, err := tfm2.ExpandApplicationVersion(tfm2.FlattenApplicationVersion(v))
+
+		if err != nil {
+			t.Errorf("version %d: unexpected error: %s", v, err)
+		} else if got != v {
+			t.Errorf("version %d: round-trip = %d", v, got)
+		}
+	}
+
+	for name, v := range map[string]types.Int64{
+		"null":           types.Int64Null(),
+		"unknown":        types.Int64Unknown(),
+		"above MaxInt32": types.Int64Value(math.MaxInt32 + 1),
+		"below MinInt32": types.Int64Value(math.MinInt32 - 1),
+	} {
+		if _, err := tfm2.ExpandApplicationVersion(v); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestApplicationUpdateCreatesVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	model := func(content, description string, tags map[string]string) tfm2.ApplicationResourceModel {
+		return tfm2.ApplicationResourceModel{
+			Definition: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfm2.DefinitionModel{
+				Content: types.StringValue(content),
+			}),
+			Description:               types.StringValue(description),
+			Tags:                      fwflex.FlattenFrameworkStringValueMap(ctx, tags),
+			TriggerVersionReplacement: fwtypes.NewMapValueOfNull[types.String](ctx),
+		}
+	}
+	old := model(`{"version":1}`, "test", map[string]string{acctest.CtKey1: acctest.CtValue1})
+
+	testCases := map[string]struct {
+		new      tfm2.ApplicationResourceModel
+		expected bool
+	}{
+		"tags only": {
+			new: model(`{"version":1}`, "test", map[string]string{acctest.CtKey1: acctest.CtValue1Updated, acctest.CtKey2: acctest.CtValue2}),
+		},
+		"definition": {
+			new:      model(`{"version":2}`, "test", map[string]string{acctest.CtKey1: acctest.CtValue1}),
+			expected: true,
+		},
+		"description": {
+			new:      model(`{"version":1}`, "updated", map[string]string{acctest.CtKey1: acctest.CtValue1}),
+			expected: true,
+		},
+		"trigger": {
+			new: func() tfm2.ApplicationResourceModel {
+				new := model(`{"version":1}`, "test", map[string]string{acctest.CtKey1: acctest.CtValue1})
+				new.TriggerVersionReplacement = fwtypes.NewMapValueOfMust[types.String](ctx, map[string]attr.Value{
+					"build": types.StringValue("2"),
+				})
+
+				return new
+			}(),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfm2.ApplicationUpdateCreatesVersion(old, testCase.new), testCase.expected; got != want {
+				t.Errorf("ApplicationUpdateCreatesVersion() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestDefinitionRedeploymentWarnings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	definition := func(content string) fwtypes.ListNestedObjectValueOf[tfm2.DefinitionModel] {
+		return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfm2.DefinitionModel{
+			Content: types.StringValue(content),
+		})
+	}
+
+	testCases := []struct {
+		name           string
+		oldContent     string
+		newContent     string
+		environmentIDs []string
+		expectWarning  bool
+	}{
+		{
+			name:           "definition change when deployed",
+			oldContent:     `{"version":1}`,
+			newContent:     `{"version":2}`,
+			environmentIDs: []string{"env-1"},
+			expectWarning:  true,
+		},
+		{
+			name:       "definition change when not deployed",
+			oldContent: `{"version":1}`,
+			newContent: `{"version":2}`,
+		},
+		{
+			name:           "no definition change",
+			oldContent:     `{"version":1}`,
+			newContent:     `{"version":1}`,
+			environmentIDs: []string{"env-1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			old := tfm2.ApplicationResourceModel{
+				Definition:             definition(testCase.oldContent),
+				DeployedEnvironmentIDs: fwflex.FlattenFrameworkStringValueListOfString(ctx, testCase.environmentIDs),
+			}
+			new := tfm2.ApplicationResourceModel{
+				Definition: definition(testCase.newContent),
+			}
+
+			diags := tfm2.DefinitionRedeploymentWarnings(ctx, old, new)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !testCase.expectWarning {
+				if got := diags.WarningsCount(); got != 0 {
+					t.Errorf("warnings = %d, want 0", got)
+				}
+
+				return
+			}
+
+			if got, want := diags.WarningsCount(), 1; got != want {
+				t.Fatalf("warnings = %d, want %d", got, want)
+			}
+
+			if got, want := diags.Warnings()[0].Summary(), "Application Redeployment Required"; got != want {
+				t.Errorf("Summary = %q, want %q", got, want)
+			}
+
+			if detail := diags.Warnings()[0].Detail(); !strings.Contains(detail, "env-1") {
+				t.Errorf("Detail = %q, want it to contain the environment ID", detail)
+			}
+		})
+	}
+}
+
+func TestDefinitionContentFileHash(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	name := filepath.Join(dir, "definition.json")
+	if err := os.WriteFile(name, []byte(`{"template-version":"2.0"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	empty := filepath.Join(dir, "empty.json")
+	if err := os.WriteFile(empty, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err := tfm2.DefinitionContentFileHash(name)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := hash, "1214e48ce5e84a8e86d032f6ff8e41cbcecfcbc73fa850f1c3871a71b8354e8c"; got != want {
+		t.Errorf("hash = %q, want %q", got, want)
+	}
+
+	if _, err := tfm2.DefinitionContentFileHash(empty); err == nil {
+		t.Error("expected error for empty file")
+	}
+
+	if _, err := tfm2.DefinitionContentFileHash(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestAccM2Application_s3ObjectVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var application m2.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccApplicationPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_s3ObjectVersion(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct1),
+					resource.TestCheckNoResourceAttr(resourceName, "definition.0.content"),
+					resource.TestCheckResourceAttrSet(resourceName, "definition.0.s3_location"),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.s3_object_version", "aws_s3_object.definition", "version_id"),
+					resource.TestMatchResourceAttr(resourceName, "definition.0.normalized_s3_location", regexache.MustCompile(`^s3://.+\?versionId=.+$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccApplicationImportStateIDWithS3LocationFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccM2Application_s3BucketAndKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var application m2.GetApplicationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccApplicationPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_s3BucketAndKey(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "current_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct1),
+					resource.TestCheckNoResourceAttr(resourceName, "definition.0.content"),
+					resource.TestCheckNoResourceAttr(resourceName, "definition.0.s3_location"),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.s3_bucket", "aws_s3_object.definition", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, "definition.0.s3_key", "aws_s3_object.definition", names.AttrKey),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.normalized_s3_location", fmt.Sprintf("s3://%s/definition.json", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestParseApplicationImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id                      string
+		expectedID              string
+		expectedS3Location      string
+		expectedS3ObjectVersion string
+		expectError             bool
+	}{
+		"ID": {
+			id:         "app-1",
+			expectedID: "app-1",
+		},
+		"S3 location": {
+			id:                 "app-1,s3://bucket/key,with,commas.json",
+			expectedID:         "app-1",
+			expectedS3Location: "s3://bucket/key,with,commas.json",
+		},
+		"S3 location with version": {
+			id:                      "app-1,s3://bucket/key.json?versionId=abc%2B123",
+			expectedID:              "app-1",
+			expectedS3Location:      "s3://bucket/key.json",
+			expectedS3ObjectVersion: "abc+123",
+		},
+		"missing S3 location": {
+			id:          "app-1,",
+			expectError: true,
+		},
+		"missing ID": {
+			id:          ",s3://bucket/key.json",
+			expectError: true,
+		},
+		"empty parts": {
+			id:          ",",
+			expectError: true,
+		},
+		"extra separator": {
+			id:          "app-1,,s3://bucket/key.json",
+			expectError: true,
+		},
+		"not an S3 URI": {
+			id:          "app-1,bucket/key.json",
+			expectError: true,
+		},
+		"empty S3 URI": {
+			id:          "app-1,s3://",
+			expectError: true,
+		},
+		"empty version": {
+			id:          "app-1,s3://bucket/key.json?versionId=",
+			expectError: true,
+		},
+		"invalid version escape": {
+			id:          "app-1,s3://bucket/key.json?versionId=abc%zz",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, s3Location, s3ObjectVersion, err := tfm2.ParseApplicationImportID(testCase.id)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := id, testCase.expectedID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+			if got, want := s3Location, testCase.expectedS3Location; got != want {
+				t.Errorf("S3 location = %q, want %q", got, want)
+			}
+			if got, want := s3ObjectVersion, testCase.expectedS3ObjectVersion; got != want {
+				t.Errorf("S3 object version = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestNormalizeDefinitionS3Location(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		s3Location      string
+		s3ObjectVersion string
+		expected        string
+	}{
+		{
+			name:       "canonical",
+			s3Location: "s3://bucket/definition.json",
+			expected:   "s3://bucket/definition.json",
+		},
+		{
+			name:       "uppercase scheme",
+			s3Location: "S3://bucket/definition.json",
+			expected:   "s3://bucket/definition.json",
+		},
+		{
+			name:            "object version",
+			s3Location:      "s3://bucket/definition.json",
+			s3ObjectVersion: "abc+def/123",
+			expected:        "s3://bucket/definition.json?versionId=abc%2Bdef%2F123",
+		},
+		{
+			name:       "key case preserved",
+			s3Location: "s3://bucket/Folder/Definition.JSON",
+			expected:   "s3://bucket/Folder/Definition.JSON",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfm2.NormalizeDefinitionS3Location(testCase.s3Location, testCase.s3ObjectVersion), testCase.expected; got != want {
+				t.Errorf("NormalizeDefinitionS3Location(%q, %q) = %q, want %q", testCase.s3Location, testCase.s3ObjectVersion, got, want)
+			}
+		})
+	}
+}
+
+func TestAccM2Application_roleARNRequired(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationConfig_secretsManagerReference(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`role_arn must be configured`),
+			},
+		},
+	})
+}
+
+func TestApplicationResourceReadEngineTypeDrift(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	r, err := tfm2.ResourceApplication(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var schemaResponse fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+
+	// State and configuration agree, but the application was changed outside Terraform.
+	data := tfm2.ApplicationResourceModel{
+		EngineType: fwtypes.StringEnumValue(awstypes.EngineTypeMicrofocus),
+	}
+
+	conn, _ := newMockClient(t, mockResponse{body: `{"applicationArn":"arn:aws:m2:us-west-2:123456789012:app/app","applicationId":"app","engineType":"bluage","status":"Available"}`}) //lintignore:AWSAT003,AWSAT005
+
+	output, err := tfm2.FindApplicationByID(ctx, conn, "app")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diags := tfm2.SetApplicationReadOutputState(ctx, &conns.AWSClient{}, output, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if got, want := data.EngineType.ValueEnum(), awstypes.EngineTypeBluage; got != want {
+		t.Fatalf("engine_type = %q, want %q", got, want)
+	}
+
+	// The unchanged configuration is then planned against the refreshed state.
+	newState := func(engineType string) tfsdk.State {
+		state := tfsdk.State{
+			Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+			Schema: schemaResponse.Schema,
+		}
+		if diags := state.SetAttribute(ctx, path.Root("engine_type"), engineType); diags.HasError() {
+			t.Fatalf("unexpected error: %s", diags)
+		}
+
+		return state
+	}
+
+	state, plan := newState(data.EngineType.ValueString()), newState(string(awstypes.EngineTypeMicrofocus))
+	request := planmodifier.StringRequest{
+		Path:        path.Root("engine_type"),
+		ConfigValue: types.StringValue(string(awstypes.EngineTypeMicrofocus)),
+		PlanValue:   types.StringValue(string(awstypes.EngineTypeMicrofocus)),
+		StateValue:  types.StringValue(data.EngineType.ValueString()),
+		Plan:        tfsdk.Plan{Raw: plan.Raw, Schema: plan.Schema},
+		State:       state,
+	}
+	response := planmodifier.StringResponse{PlanValue: request.PlanValue}
+
+	attribute := schemaResponse.Schema.Attributes["engine_type"].(schema.StringAttribute)
+	for _, v := range attribute.StringPlanModifiers() {
+		v.PlanModifyString(ctx, request, &response)
+	}
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %s", response.Diagnostics)
+	}
+
+	if !response.RequiresReplace {
+		t.Error("expected the drifted engine_type to require replacement")
+	}
+}
+
+func TestApplicationCreateTimeout(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"create": types.StringType,
+	}
+
+	testCases := map[string]struct {
+		timeouts   timeouts.Value
+		engineType awstypes.EngineType
+		expected   time.Duration
+	}{
+		"bluage default": {
+			timeouts:   timeouts.Value{Object: types.ObjectNull(attrTypes)},
+			engineType: awstypes.EngineTypeBluage,
+			expected:   60 * time.Minute,
+		},
+		"microfocus default": {
+			timeouts:   timeouts.Value{Object: types.ObjectNull(attrTypes)},
+			engineType: awstypes.EngineTypeMicrofocus,
+			expected:   30 * time.Minute,
+		},
+		"configured": {
+			timeouts: timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"create": types.StringValue("90m"),
+			})},
+			engineType: awstypes.EngineTypeBluage,
+			expected:   90 * time.Minute,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfm2.ApplicationCreateTimeout(context.Background(), testCase.timeouts, testCase.engineType), testCase.expected; got != want {
+				t.Errorf("ApplicationCreateTimeout() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestDefinitionContentSizeWarningValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value           types.String
+		expectedWarning bool
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+		"small": {
+			value: types.StringValue(`{"template-version": "2.0"}`),
+		},
+		"ascii below threshold": {
+			value: types.StringValue(strings.Repeat("a", 61749)),
+		},
+		"ascii at threshold": {
+			value:           types.StringValue(strings.Repeat("a", 61750)),
+			expectedWarning: true,
+		},
+		"ascii at limit": {
+			value:           types.StringValue(strings.Repeat("a", 65000)),
+			expectedWarning: true,
+		},
+		"ascii over limit": {
+			value: types.StringValue(strings.Repeat("a", 65001)),
+		},
+		// 20600 characters, 61800 bytes.
+		"multibyte near limit": {
+			value:           types.StringValue(strings.Repeat("€", 20600)),
+			expectedWarning: true,
+		},
+		// 20000 characters, 60000 bytes.
+		"multibyte below threshold": {
+			value: types.StringValue(strings.Repeat("€", 20000)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:        path.Root("content"),
+				ConfigValue: testCase.value,
+			}
+			response := validator.StringResponse{}
+			tfm2.DefinitionContentSizeWarningValidator().ValidateString(context.Background(), request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", response.Diagnostics)
+			}
+
+			if got, want := response.Diagnostics.WarningsCount() > 0, testCase.expectedWarning; got != want {
+				t.Errorf("warning = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitApplicationCreated_failed(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t, mockResponse{
+		body: `{"applicationId":"app","status":"Failed","statusReason":"invalid application definition"}`,
+	})
+
+	_, err := tfm2.WaitApplicationCreated(context.Background(), conn, "app", 30*time.Minute)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := err.Error(), "invalid application definition"; !strings.Contains(got, want) {
+		t.Errorf("error = %q, want to contain %q", got, want)
+	}
+
+	if got, want := httpClient.requestCount(), 1; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestWaitApplicationRunning(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{"applicationId":"app","status":"Starting"}`},
+		mockResponse{body: `{"applicationId":"app","status":"Running"}`},
+		mockResponse{body: `{"applicationId":"app","status":"Running"}`},
+	)
+
+	output, err := tfm2.WaitApplicationRunning(context.Background(), conn, "app", 30*time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := output.Status, awstypes.ApplicationLifecycleRunning; got != want {
+		t.Errorf("status = %q, want %q", got, want)
+	}
+
+	if got, want := httpClient.requestCount(), 3; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestWaitApplicationRunning_failed(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{"applicationId":"app","status":"Starting"}`},
+		mockResponse{body: `{"applicationId":"app","status":"Failed","statusReason":"insufficient capacity"}`},
+	)
+
+	_, err := tfm2.WaitApplicationRunning(context.Background(), conn, "app", 30*time.Minute)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := err.Error(), "insufficient capacity"; !strings.Contains(got, want) {
+		t.Errorf("error = %q, want to contain %q", got, want)
+	}
+
+	if got, want := httpClient.requestCount(), 2; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestDeleteApplication_conflict(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses   []mockResponse
+		expectedErr string
+	}{
+		"deployed": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusConflict, "ConflictException", "application has deployments"),
+				{body: `{"deployments":[{"deploymentId":"dep-2","environmentId":"env-2","status":"Succeeded"},{"deploymentId":"dep-1","environmentId":"env-1","status":"Succeeded"}]}`},
+			},
+			expectedErr: "still deployed to environments (env-1, env-2)",
+		},
+		"deployments unreadable": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusConflict, "ConflictException", "application has deployments"),
+				mockErrorResponse(http.StatusBadRequest, "AccessDeniedException", "denied"),
+			},
+			expectedErr: "The application is still deployed.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			err := tfm2.DeleteApplication(context.Background(), conn, "app")
+
+			if got, want := httpClient.requestCount(), len(testCase.responses); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			if !errs.IsA[*awstypes.ConflictException](err) {
+				t.Fatalf("expected ConflictException, got %v", err)
+			}
+
+			if got, want := err.Error(), testCase.expectedErr; !strings.Contains(got, want) {
+				t.Errorf("error = %q, want to contain %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDeleteApplication_conflictRetried(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockErrorResponse(http.StatusConflict, "ConflictException", "application is transitioning"),
+		mockResponse{body: `{"deployments":[]}`},
+		mockErrorResponse(http.StatusConflict, "ConflictException", "application is transitioning"),
+		mockResponse{body: `{"deployments":[]}`},
+		mockResponse{body: `{}`},
+	)
+
+	if err := tfm2.DeleteApplication(context.Background(), conn, "app"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := httpClient.requestCount(), 5; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestWaitApplicationStopped(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{"applicationId":"app","status":"Stopping"}`},
+		mockResponse{body: `{"applicationId":"app","status":"Stopped"}`},
+		mockResponse{body: `{"applicationId":"app","status":"Stopped"}`},
+	)
+
+	output, err := tfm2.WaitApplicationStopped(context.Background(), conn, "app", 30*time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := output.Status, awstypes.ApplicationLifecycleStopped; got != want {
+		t.Errorf("status = %q, want %q", got, want)
+	}
+
+	if got, want := httpClient.requestCount(), 3; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestStopApplication_blocked(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{}`},
+		mockResponse{body: `{"applicationId":"app","status":"Stopping"}`},
+		mockResponse{body: `{"batchJobExecutions":[{"applicationId":"app","executionId":"exec-1","jobName":"NIGHTLY","status":"Running"},{"applicationId":"app","executionId":"exec-2","jobName":"WEEKLY","status":"Succeeded"}]}`},
+	)
+
+	_, err := tfm2.StopApplication(context.Background(), conn, "app", false, 50*time.Millisecond, 30*time.Minute)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := err.Error(), "blocked by in-progress batch job execution(s) NIGHTLY (exec-1);"; !strings.Contains(got, want) {
+		t.Errorf("error = %q, want to contain %q", got, want)
+	}
+
+	if got, want := httpClient.requestCount(), 3; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestStopApplication_forced(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{}`},
+		mockResponse{body: `{"applicationId":"app","status":"Stopping"}`},
+		mockResponse{body: `{}`},
+		mockResponse{body: `{"applicationId":"app","status":"Stopped"}`},
+		mockResponse{body: `{"applicationId":"app","status":"Stopped"}`},
+	)
+
+	output, err := tfm2.StopApplication(context.Background(), conn, "app", true, 50*time.Millisecond, 30*time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := output.Status, awstypes.ApplicationLifecycleStopped; got != want {
+		t.Errorf("status = %q, want %q", got, want)
+	}
+
+	if got, want := httpClient.requestCount(), 5; got != want {
+		t.Fatalf("requests = %d, want %d", got, want)
+	}
+
+	for i, want := range map[int]bool{0: false, 2: true} {
+		if got := strings.Contains(httpClient.bodies[i], `"forceStop":true`); got != want {
+			t.Errorf("request %d forced stop = %t, want %t", i, got, want)
+		}
+	}
+}
+
+func TestStopApplicationBeforeDelete(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses      []mockResponse
+		expectedStatus awstypes.ApplicationLifecycle
+		expectStopped  bool
+		expectRequests int
+	}{
+		"running": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app","status":"Running"}`},
+				{body: `{}`},
+				{body: `{"applicationId":"app","status":"Stopped"}`},
+				{body: `{"applicationId":"app","status":"Stopped"}`},
+			},
+			expectedStatus: awstypes.ApplicationLifecycleStopped,
+			expectStopped:  true,
+			expectRequests: 4,
+		},
+		"starting": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app","status":"Starting"}`},
+				{body: `{"applicationId":"app","status":"Running"}`},
+				{body: `{"applicationId":"app","status":"Running"}`},
+				{body: `{}`},
+				{body: `{"applicationId":"app","status":"Stopped"}`},
+				{body: `{"applicationId":"app","status":"Stopped"}`},
+			},
+			expectedStatus: awstypes.ApplicationLifecycleStopped,
+			expectStopped:  true,
+			expectRequests: 6,
+		},
+		"stopping": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app","status":"Stopping"}`},
+				{body: `{"applicationId":"app","status":"Stopped"}`},
+				{body: `{"applicationId":"app","status":"Stopped"}`},
+			},
+			expectedStatus: awstypes.ApplicationLifecycleStopped,
+			expectRequests: 3,
+		},
+		"stopped": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app","status":"Stopped"}`},
+			},
+			expectRequests: 1,
+		},
+		"not found": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "application not found"),
+			},
+			expectRequests: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			output, err := tfm2.StopApplicationBeforeDelete(context.Background(), conn, "app", 30*time.Minute)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var status awstypes.ApplicationLifecycle
+			if output != nil {
+				status = output.Status
+			}
+
+			if got, want := status, testCase.expectedStatus; got != want {
+				t.Errorf("status = %q, want %q", got, want)
+			}
+
+			if got, want := httpClient.requestCount(), testCase.expectRequests; got != want {
+				t.Fatalf("requests = %d, want %d", got, want)
+			}
+
+			stopped := slices.ContainsFunc(httpClient.requests, func(v *http.Request) bool {
+				return strings.HasSuffix(v.URL.Path, "/stop")
+			})
+
+			if got, want := stopped, testCase.expectStopped; got != want {
+				t.Errorf("StopApplication called = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestFindApplicationVersionByTwoPartKeyWithRetry(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses     []mockResponse
+		expectError   bool
+		expectVersion int32
+	}{
+		"found": {
+			responses: []mockResponse{
+				{body: `{"applicationVersion":1,"status":"Available"}`},
+			},
+			expectVersion: 1,
+		},
+		"not found then found": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "application version not found"),
+				{body: `{"applicationVersion":1,"status":"Available"}`},
+			},
+			expectVersion: 1,
+		},
+		"no definition content": {
+			responses: []mockResponse{
+				{body: `{"applicationVersion":1,"name":"app","status":"Available"}`},
+			},
+			expectVersion: 1,
+		},
+		"other error": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusBadRequest, "ValidationException", "invalid request"),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			output, err := tfm2.FindApplicationVersionByTwoPartKeyWithRetry(context.Background(), conn, "app", 1, 1*time.Minute)
+
+			if got, want := httpClient.requestCount(), len(testCase.responses); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToInt32(output.ApplicationVersion), testCase.expectVersion; got != want {
+				t.Errorf("ApplicationVersion = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestFindApplicationVersionByCurrentOrLatest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentVersion   types.Int64
+		latestVersion    *int32
+		responses        []mockResponse
+		expectError      bool
+		expectVersion    int32
+		expectDefinition string
+	}{
+		"pinned older version": {
+			latestVersion:  aws.Int32(2),
+			currentVersion: types.Int64Value(1),
+			responses: []mockResponse{
+				{body: `{"applicationVersion":1,"definitionContent":"v1","status":"Available"}`},
+			},
+			expectVersion:    1,
+			expectDefinition: "v1",
+		},
+		"pinned latest version": {
+			latestVersion:  aws.Int32(2),
+			currentVersion: types.Int64Value(2),
+			responses: []mockResponse{
+				{body: `{"applicationVersion":2,"definitionContent":"v2","status":"Available"}`},
+			},
+			expectVersion:    2,
+			expectDefinition: "v2",
+		},
+		"pinned version not found": {
+			latestVersion:  aws.Int32(2),
+			currentVersion: types.Int64Value(1),
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "application version not found"),
+				{body: `{"applicationVersion":2,"definitionContent":"v2","status":"Available"}`},
+			},
+			expectVersion:    2,
+			expectDefinition: "v2",
+		},
+		"no version in state": {
+			latestVersion:  aws.Int32(2),
+			currentVersion: types.Int64Null(),
+			responses: []mockResponse{
+				{body: `{"applicationVersion":2,"definitionContent":"v2","status":"Available"}`},
+			},
+			expectVersion:    2,
+			expectDefinition: "v2",
+		},
+		"no latest version": {
+			currentVersion: types.Int64Value(1),
+			responses: []mockResponse{
+				{body: `{"applicationVersion":1,"definitionContent":"v1","status":"Available"}`},
+			},
+			expectVersion:    1,
+			expectDefinition: "v1",
+		},
+		"no latest version or version in state": {
+			currentVersion: types.Int64Null(),
+			expectError:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			output, err := tfm2.FindApplicationVersionByCurrentOrLatest(context.Background(), conn, "app", testCase.currentVersion, testCase.latestVersion)
+
+			if got, want := httpClient.requestCount(), len(testCase.responses); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToInt32(output.ApplicationVersion), testCase.expectVersion; got != want {
+				t.Errorf("ApplicationVersion = %d, want %d", got, want)
+			}
+
+			if got, want := aws.ToString(output.DefinitionContent), testCase.expectDefinition; got != want {
+				t.Errorf("DefinitionContent = %q, want %q", got, want)
+			}
+
+			if got, want := httpClient.requests[len(httpClient.requests)-1].URL.Path, fmt.Sprintf("/applications/app/versions/%d", testCase.expectVersion); got != want {
+				t.Errorf("request path = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestUpdateApplicationDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses     []mockResponse
+		expectVersion int32
+	}{
+		"version unchanged": {
+			responses: []mockResponse{
+				{body: `{"applicationVersion":1}`},
+			},
+			expectVersion: 1,
+		},
+		"new version": {
+			responses: []mockResponse{
+				{body: `{"applicationVersion":2}`},
+				{body: `{"applicationVersion":2,"status":"Available"}`},
+			},
+			expectVersion: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			version, err := tfm2.UpdateApplicationDescription(context.Background(), conn, "app", 1, aws.String("updated"), 30*time.Minute)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := version, testCase.expectVersion; got != want {
+				t.Errorf("version = %d, want %d", got, want)
+			}
+
+			if got, want := httpClient.requestCount(), len(testCase.responses); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestCheckApplicationNameAvailable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses   []mockResponse
+		expectedErr string
+	}{
+		"available": {
+			responses: []mockResponse{
+				{body: `{"applications":[]}`},
+			},
+		},
+		"duplicate": {
+			responses: []mockResponse{
+				{body: `{"applications":[{"applicationId":"app-1","name":"test"}]}`},
+			},
+			expectedErr: "application named test already exists",
+		},
+		"duplicates": {
+			responses: []mockResponse{
+				{body: `{"applications":[{"applicationId":"app-1","name":"test"}],"nextToken":"token"}`},
+				{body: `{"applications":[{"applicationId":"app-2","name":"test"}]}`},
+			},
+			expectedErr: "application named test already exists",
+		},
+		"list error": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusBadRequest, "AccessDeniedException", "denied"),
+			},
+			expectedErr: "denied",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			err := tfm2.CheckApplicationNameAvailable(context.Background(), conn, "test")
+
+			if got, want := httpClient.requestCount(), len(testCase.responses); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			switch {
+			case testCase.expectedErr == "":
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			case err == nil:
+				t.Fatalf("expected error containing %q, got nil", testCase.expectedErr)
+			case !strings.Contains(err.Error(), testCase.expectedErr):
+				t.Errorf("error = %q, want it to contain %q", err, testCase.expectedErr)
+			}
+		})
+	}
+}
+
+func TestDefinitionContentMaxLengthValidator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		names.AttrContent: tftypes.String,
+		"staging_bucket":  tftypes.String,
+	}}
+	configSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrContent: schema.StringAttribute{Optional: true},
+			"staging_bucket":  schema.StringAttribute{Optional: true},
+		},
+	}
+
+	testCases := map[string]struct {
+		content       string
+		stagingBucket *string
+		expectError   bool
+	}{
+		"at limit": {
+			content: strings.Repeat("a", 65000),
+		},
+		"over limit": {
+			content:     strings.Repeat("a", 65001),
+			expectError: true,
+		},
+		"over limit with staging bucket": {
+			content:       strings.Repeat("a", 65001),
+			stagingBucket: aws.String("bucket"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var stagingBucket tftypes.Value
+			if testCase.stagingBucket != nil {
+				stagingBucket = tftypes.NewValue(tftypes.String, aws.ToString(testCase.stagingBucket))
+			} else {
+				stagingBucket = tftypes.NewValue(tftypes.String, nil)
+			}
+
+			request := validator.StringRequest{
+				Path:        path.Root(names.AttrContent),
+				ConfigValue: types.StringValue(testCase.content),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						names.AttrContent: tftypes.NewValue(tftypes.String, testCase.content),
+						"staging_bucket":  stagingBucket,
+					}),
+					Schema: configSchema,
+				},
+			}
+			response := validator.StringResponse{}
+			tfm2.DefinitionContentMaxLengthValidator().ValidateString(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDefinitionBlockCountValidator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	objectType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		names.AttrContent: types.StringType,
+	}}
+	definition := types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+		names.AttrContent: types.StringValue("{}"),
+	})
+
+	testCases := map[string]struct {
+		value         types.List
+		expectSummary string
+	}{
+		"null": {
+			value:         types.ListNull(objectType),
+			expectSummary: "Missing Definition Block",
+		},
+		"zero": {
+			value:         types.ListValueMust(objectType, []attr.Value{}),
+			expectSummary: "Missing Definition Block",
+		},
+		"one": {
+			value: types.ListValueMust(objectType, []attr.Value{definition}),
+		},
+		"two": {
+			value:         types.ListValueMust(objectType, []attr.Value{definition, definition}),
+			expectSummary: "Too Many Definition Blocks",
+		},
+		"unknown": {
+			value: types.ListUnknown(objectType),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.ListRequest{
+				Path:        path.Root("definition"),
+				ConfigValue: testCase.value,
+			}
+			response := validator.ListResponse{}
+			tfm2.DefinitionBlockCountValidator().ValidateList(ctx, request, &response)
+
+			if testCase.expectSummary == "" {
+				if response.Diagnostics.HasError() {
+					t.Errorf("unexpected error: %v", response.Diagnostics)
+				}
+
+				return
+			}
+
+			if got, want := response.Diagnostics.ErrorsCount(), 1; got != want {
+				t.Fatalf("ErrorsCount = %d, want %d: %v", got, want, response.Diagnostics)
+			}
+			if got, want := response.Diagnostics.Errors()[0].Summary(), testCase.expectSummary; got != want {
+				t.Errorf("Summary = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDefinitionUseStateWhenUnchanged(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	state := fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfm2.DefinitionModel{
+		NormalizedS3Location: types.StringValue("s3://bucket/definition.json?versionId=v1"),
+		S3Location:           types.StringValue("s3://bucket/definition.json"),
+		S3ObjectVersion:      types.StringValue("v1"),
+	}).ListValue
+	definitionType := fwtypes.NewListNestedObjectTypeOf[tfm2.DefinitionModel](ctx)
+
+	testCases := map[string]struct {
+		state       types.List
+		plan        types.List
+		expectState bool
+	}{
+		"tags only": {
+			state: state,
+			plan: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfm2.DefinitionModel{
+				ContentFileHash:      types.StringUnknown(),
+				NormalizedS3Location: types.StringUnknown(),
+				S3Location:           types.StringValue("s3://bucket/definition.json"),
+				S3ObjectVersion:      types.StringValue("v1"),
+				StagedS3Location:     types.StringUnknown(),
+			}).ListValue,
+			expectState: true,
+		},
+		"s3 object version changed": {
+			state: state,
+			plan: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfm2.DefinitionModel{
+				ContentFileHash:      types.StringUnknown(),
+				NormalizedS3Location: types.StringUnknown(),
+				S3Location:           types.StringValue("s3://bucket/definition.json"),
+				S3ObjectVersion:      types.StringValue("v2"),
+				StagedS3Location:     types.StringUnknown(),
+			}).ListValue,
+		},
+		"switched to content": {
+			state: state,
+			plan: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfm2.DefinitionModel{
+				Content:              types.StringValue("{}"),
+				ContentFileHash:      types.StringUnknown(),
+				NormalizedS3Location: types.StringUnknown(),
+				StagedS3Location:     types.StringUnknown(),
+			}).ListValue,
+		},
+		"create": {
+			state: types.ListNull(definitionType.ElemType),
+			plan:  state,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := planmodifier.ListRequest{
+				Path:        path.Root("definition"),
+				ConfigValue: testCase.plan,
+				PlanValue:   testCase.plan,
+				StateValue:  testCase.state,
+			}
+			response := planmodifier.ListResponse{PlanValue: request.PlanValue}
+			tfm2.DefinitionUseStateWhenUnchanged().PlanModifyList(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			want := testCase.plan
+			if testCase.expectState {
+				want = testCase.state
+			}
+
+			if got := response.PlanValue; !got.Equal(want) {
+				t.Errorf("PlanValue = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestFlattenDefinitionStagedS3Location(t *testing.T) {
+	t.Parallel()
+
+	large := strings.Repeat("a", 65001)
+
+	testCases := map[string]struct {
+		definition tfm2.DefinitionModel
+		expected   types.String
+	}{
+		"no staging bucket": {
+			definition: tfm2.DefinitionModel{
+				Content: types.StringValue(large),
+			},
+			expected: types.StringNull(),
+		},
+		"content within limit": {
+			definition: tfm2.DefinitionModel{
+				Content:       types.StringValue(`{"template-version":"2.0"}`),
+				StagingBucket: types.StringValue("bucket"),
+			},
+			expected: types.StringNull(),
+		},
+		"content over limit": {
+			definition: tfm2.DefinitionModel{
+				Content:       types.StringValue(large),
+				StagingBucket: types.StringValue("bucket"),
+			},
+			expected: types.StringValue(fmt.Sprintf("s3://bucket/app/definition-%x.json", sha256.Sum256([]byte(large)))),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfm2.FlattenDefinitionStagedS3Location("app", &testCase.definition), testCase.expected; !got.Equal(want) {
+				t.Errorf("FlattenDefinitionStagedS3Location = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestStageDefinitionContent(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockS3Client(t, mockResponse{})
+
+	err := tfm2.StageDefinitionContent(context.Background(), conn, &tfm2.DefinitionModel{
+		Content:          types.StringValue(`{"template-version":"2.0"}`),
+		StagedS3Location: types.StringValue("s3://bucket/app/definition.json"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := httpClient.requestCount(), 1; got != want {
+		t.Fatalf("requests = %d, want %d", got, want)
+	}
+
+	if got, want := httpClient.requests[0].Method, http.MethodPut; got != want {
+		t.Errorf("method = %s, want %s", got, want)
+	}
+
+	if got, want := httpClient.requests[0].URL.Path, "/bucket/app/definition.json"; got != want {
+		t.Errorf("path = %q, want %q", got, want)
+	}
+
+	if got, want := httpClient.bodies[0], `{"template-version":"2.0"}`; got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
+
+func TestDeleteStagedDefinitionContent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses   []mockResponse
+		expectError bool
+	}{
+		"deleted": {
+			responses: []mockResponse{
+				{statusCode: http.StatusNoContent},
+			},
+		},
+		"bucket deleted": {
+			responses: []mockResponse{
+				{statusCode: http.StatusNotFound, body: `<Error><Code>NoSuchBucket</Code><Message>The specified bucket does not exist</Message></Error>`},
+			},
+		},
+		"access denied": {
+			responses: []mockResponse{
+				{statusCode: http.StatusForbidden, body: `<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockS3Client(t, testCase.responses...)
+
+			err := tfm2.DeleteStagedDefinitionContent(context.Background(), conn, "s3://bucket/app/definition.json")
+
+			if got, want := httpClient.requestCount(), len(testCase.responses); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, want error %t", err, want)
+			}
+
+			if got, want := httpClient.requests[0].Method, http.MethodDelete; got != want {
+				t.Errorf("method = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestDefinitionS3LocationValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       types.String
+		expectError bool
+	}{
+		"valid": {
+			value: types.StringValue("s3://bucket/path/definition.json"),
+		},
+		"uppercase scheme": {
+			value: types.StringValue("S3://bucket/definition.json"),
+		},
+		"https URL": {
+			value:       types.StringValue("https://bucket.s3.amazonaws.com/definition.json"), //lintignore:AWSAT004
+			expectError: true,
+		},
+		"no scheme": {
+			value:       types.StringValue("bucket/definition.json"),
+			expectError: true,
+		},
+		"no key": {
+			value:       types.StringValue("s3://bucket/"),
+			expectError: true,
+		},
+		"null": {
+			value: types.StringNull(),
+		},
+		"unknown": {
+			value: types.StringUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:        path.Root("definition").AtListIndex(0).AtName("s3_location"),
+				ConfigValue: testCase.value,
+			}
+			response := validator.StringResponse{}
+			tfm2.DefinitionS3LocationValidator().ValidateString(context.Background(), request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDefinitionS3Location(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data     tfm2.DefinitionModel
+		expected types.String
+	}{
+		"s3 location": {
+			data: tfm2.DefinitionModel{
+				S3Location: types.StringValue("s3://bucket/definition.json"),
+			},
+			expected: types.StringValue("s3://bucket/definition.json"),
+		},
+		"s3 bucket and key": {
+			data: tfm2.DefinitionModel{
+				S3Bucket: types.StringValue("bucket"),
+				S3Key:    types.StringValue("path/definition.json"),
+			},
+			expected: types.StringValue("s3://bucket/path/definition.json"),
+		},
+		"unknown s3 key": {
+			data: tfm2.DefinitionModel{
+				S3Bucket: types.StringValue("bucket"),
+				S3Key:    types.StringUnknown(),
+			},
+			expected: types.StringUnknown(),
+		},
+		"content": {
+			data: tfm2.DefinitionModel{
+				Content: types.StringValue("{}"),
+			},
+			expected: types.StringNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfm2.DefinitionS3Location(&testCase.data), testCase.expected; !got.Equal(want) {
+				t.Errorf("S3 location = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestDefinitionS3ObjectVersionValidator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"s3_bucket":         tftypes.String,
+		"s3_location":       tftypes.String,
+		"s3_object_version": tftypes.String,
+	}}
+	configSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"s3_bucket":         schema.StringAttribute{Optional: true},
+			"s3_location":       schema.StringAttribute{Optional: true},
+			"s3_object_version": schema.StringAttribute{Optional: true},
+		},
+	}
+
+	testCases := map[string]struct {
+		s3Bucket    *string
+		s3Location  *string
+		expectError bool
+	}{
+		"with s3 location": {
+			s3Location: aws.String("s3://bucket/definition.json"),
+		},
+		"with s3 bucket": {
+			s3Bucket: aws.String("bucket"),
+		},
+		"without s3 source": {
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:        path.Root("s3_object_version"),
+				ConfigValue: types.StringValue("v1"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"s3_bucket":         tftypes.NewValue(tftypes.String, testCase.s3Bucket),
+						"s3_location":       tftypes.NewValue(tftypes.String, testCase.s3Location),
+						"s3_object_version": tftypes.NewValue(tftypes.String, "v1"),
+					}),
+					Schema: configSchema,
+				},
+			}
+			response := validator.StringResponse{}
+			tfm2.DefinitionS3ObjectVersionValidator().ValidateString(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDefinitionS3LocationWarnings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s3Location      string
+		responses       []mockResponse
+		expectedWarning string
+	}{
+		"readable": {
+			s3Location: "s3://bucket/definition.json",
+			responses: []mockResponse{
+				{},
+				{header: map[string]string{"X-Amz-Bucket-Region": "us-west-2"}}, //lintignore:AWSAT003
+			},
+		},
+		"cross-region bucket": {
+			s3Location: "s3://bucket/definition.json",
+			responses: []mockResponse{
+				{},
+				{header: map[string]string{"X-Amz-Bucket-Region": "eu-west-1"}}, //lintignore:AWSAT003
+			},
+			expectedWarning: "Definition S3 Bucket In Different Region",
+		},
+		"bucket region unknown": {
+			s3Location: "s3://bucket/definition.json",
+			responses: []mockResponse{
+				{},
+				{statusCode: http.StatusForbidden},
+			},
+		},
+		"missing object": {
+			s3Location: "s3://bucket/missing.json",
+			responses: []mockResponse{
+				{statusCode: http.StatusNotFound},
+			},
+			expectedWarning: "Definition S3 Object Can't Be Read",
+		},
+		"invalid URI": {
+			s3Location:      "s3://bucket",
+			expectedWarning: "Unable to Validate Definition S3 Location",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockS3Client(t, testCase.responses...)
+
+			diags := tfm2.DefinitionS3LocationWarnings(context.Background(), conn, testCase.s3Location, "", "us-west-2") //lintignore:AWSAT003
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := httpClient.requestCount(), len(testCase.responses); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			if testCase.expectedWarning == "" {
+				if got := diags.WarningsCount(); got != 0 {
+					t.Errorf("unexpected warnings: %v", diags)
+				}
+
+				return
+			}
+
+			if got, want := diags.WarningsCount(), 1; got != want {
+				t.Fatalf("warnings = %d, want %d", got, want)
+			}
+
+			if got, want := diags.Warnings()[0].Summary(), testCase.expectedWarning; got != want {
+				t.Errorf("Summary = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestRoleARNAccountDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		roleARN     string
+		accountID   string
+		expectError bool
+	}{
+		"same account": {
+			roleARN:   "arn:aws:iam::123456789012:role/test",
+			accountID: "123456789012",
+		},
+		"cross account": {
+			roleARN:     "arn:aws:iam::210987654321:role/test",
+			accountID:   "123456789012",
+			expectError: true,
+		},
+		"unknown account": {
+			roleARN: "arn:aws:iam::210987654321:role/test",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tfm2.RoleARNAccountDiagnostics(testCase.roleARN, testCase.accountID)
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
+
+func TestRoleARNPartitionDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		roleARN     string
+		partition   string
+		expectError bool
+	}{
+		"commercial": {
+			roleARN:   "arn:aws:iam::123456789012:role/test",
+			partition: names.StandardPartitionID,
+		},
+		"GovCloud": {
+			roleARN:   "arn:aws-us-gov:iam::123456789012:role/test",
+			partition: names.USGovCloudPartitionID,
+		},
+		"China": {
+			roleARN:   "arn:aws-cn:iam::123456789012:role/test",
+			partition: names.ChinaPartitionID,
+		},
+		"commercial role in GovCloud": {
+			roleARN:     "arn:aws:iam::123456789012:role/test",
+			partition:   names.USGovCloudPartitionID,
+			expectError: true,
+		},
+		"commercial role in China": {
+			roleARN:     "arn:aws:iam::123456789012:role/test",
+			partition:   names.ChinaPartitionID,
+			expectError: true,
+		},
+		"GovCloud role in China": {
+			roleARN:     "arn:aws-us-gov:iam::123456789012:role/test",
+			partition:   names.ChinaPartitionID,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tfm2.RoleARNPartitionDiagnostics(testCase.roleARN, testCase.partition)
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
+
+func TestRoleTrustPolicyAllowsService(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		document      string
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name:     "trusted",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"m2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			expected: true,
+		},
+		{
+			name:     "trusted with multiple services",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com","m2.amazonaws.com"]},"Action":["sts:TagSession","sts:AssumeRole"]}]}`,
+			expected: true,
+		},
+		{
+			name:     "other service",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			name:     "denied",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"Service":"m2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			name:     "other action",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"m2.amazonaws.com"},"Action":"sts:TagSession"}]}`,
+		},
+		{
+			name:          "invalid",
+			document:      `not JSON`,
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfm2.RoleTrustPolicyAllowsService(testCase.document, "m2.amazonaws.com")
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+
+			if want := testCase.expected; got != want {
+				t.Errorf("RoleTrustPolicyAllowsService = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestApplicationResourceModelFlattenARN(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	arn := "arn:aws:m2:us-west-2:123456789012:app/app-1" //lintignore:AWSAT003,AWSAT005
+
+	var data tfm2.ApplicationResourceModel
+	if diags := fwflex.Flatten(ctx, &m2.GetApplicationOutput{
+		ApplicationArn: aws.String(arn),
+		ApplicationId:  aws.String("app-1"),
+	}, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := data.ApplicationARN.ValueString(), arn; got != want {
+		t.Errorf("ApplicationARN = %q, want %q", got, want)
+	}
+}
+
+func TestApplicationResourceModelFlattenCreationTime(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var data tfm2.ApplicationResourceModel
+	if diags := fwflex.Flatten(ctx, &m2.GetApplicationOutput{
+		ApplicationId: aws.String("app-1"),
+		CreationTime:  aws.Time(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+	}, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := data.CreationTime.ValueString(), "2024-01-02T03:04:05Z"; got != want {
+		t.Errorf("CreationTime = %q, want %q", got, want)
+	}
+}
+
+func TestApplicationResourceModelFlattenDescription(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Read flattens GetApplication, not GetApplicationVersion, so description drift is read from the application.
+	testCases := map[string]struct {
+		description *string
+		expected    types.String
+	}{
+		"changed": {
+			description: aws.String("changed in console"),
+			expected:    types.StringValue("changed in console"),
+		},
+		"removed": {
+			expected: types.StringNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := tfm2.ApplicationResourceModel{
+				Description: types.StringValue("configured"),
+			}
+			if diags := fwflex.Flatten(ctx, &m2.GetApplicationOutput{
+				ApplicationId: aws.String("app-1"),
+				Description:   testCase.description,
+			}, &data); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := data.Description, testCase.expected; !got.Equal(want) {
+				t.Errorf("Description = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestApplicationResourceModelFlattenStatusReason(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		statusReason *string
+		expected     types.String
+	}{
+		"set": {
+			statusReason: aws.String("Application failed to start: insufficient capacity"),
+			expected:     types.StringValue("Application failed to start: insufficient capacity"),
+		},
+		"cleared": {
+			expected: types.StringNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := tfm2.ApplicationResourceModel{
+				StatusReason: types.StringValue("previous reason"),
+			}
+			if diags := fwflex.Flatten(ctx, &m2.GetApplicationOutput{
+				ApplicationId: aws.String("app-1"),
+				Status:        awstypes.ApplicationLifecycleFailed,
+				StatusReason:  testCase.statusReason,
+			}, &data); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := data.StatusReason, testCase.expected; !got.Equal(want) {
+				t.Errorf("StatusReason = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestApplicationResourceModelFlattenLogGroups(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var data tfm2.ApplicationResourceModel
+	if diags := fwflex.Flatten(ctx, &m2.GetApplicationOutput{
+		ApplicationId: aws.String("app-1"),
+		LogGroups: []awstypes.LogGroupSummary{
+			{
+				LogGroupName: aws.String("/aws/vendedlogs/m2/app-1/ConsoleLog"),
+				LogType:      aws.String("ConsoleLog"),
+			},
+		},
+	}, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	logGroups, diags := data.LogGroups.ToSlice(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := len(logGroups), 1; got != want {
+		t.Fatalf("log_groups = %d, want %d", got, want)
+	}
+
+	if got, want := logGroups[0].LogGroupName.ValueString(), "/aws/vendedlogs/m2/app-1/ConsoleLog"; got != want {
+		t.Errorf("log_group_name = %q, want %q", got, want)
+	}
+
+	if got, want := logGroups[0].LogType.ValueString(), "ConsoleLog"; got != want {
+		t.Errorf("log_type = %q, want %q", got, want)
+	}
+}
+
+func TestApplicationResourceModelFlattenRoleARN(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const configured = "arn:aws:iam::123456789012:role/configured" //lintignore:AWSAT005
+
+	// role_arn is compared byte for byte, so any difference in the returned ARN, including case or partition, plans a replacement.
+	testCases := map[string]struct {
+		roleARN       *string
+		expected      fwtypes.ARN
+		expectReplace bool
+	}{
+		"unchanged": {
+			roleARN:  aws.String(configured),
+			expected: fwtypes.ARNValue(configured),
+		},
+		"changed": {
+			roleARN:       aws.String("arn:aws:iam::123456789012:role/other"),       //lintignore:AWSAT005
+			expected:      fwtypes.ARNValue("arn:aws:iam::123456789012:role/other"), //lintignore:AWSAT005
+			expectReplace: true,
+		},
+		"case": {
+			roleARN:       aws.String("arn:aws:iam::123456789012:role/Configured"),       //lintignore:AWSAT005
+			expected:      fwtypes.ARNValue("arn:aws:iam::123456789012:role/Configured"), //lintignore:AWSAT005
+			expectReplace: true,
+		},
+		"partition": {
+			roleARN:       aws.String("arn:aws-us-gov:iam::123456789012:role/configured"),       //lintignore:AWSAT005
+			expected:      fwtypes.ARNValue("arn:aws-us-gov:iam::123456789012:role/configured"), //lintignore:AWSAT005
+			expectReplace: true,
+		},
+		"removed": {
+			expected:      fwtypes.ARNNull(),
+			expectReplace: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := tfm2.ApplicationResourceModel{
+				RoleARN: fwtypes.ARNValue(configured),
+			}
+			if diags := fwflex.Flatten(ctx, &m2.GetApplicationOutput{
+				ApplicationId: aws.String("app-1"),
+				RoleArn:       testCase.roleARN,
+			}, &data); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := data.RoleARN, testCase.expected; !got.Equal(want) {
+				t.Errorf("RoleARN = %s, want %s", got, want)
+			}
+
+			if got, want := !data.RoleARN.Equal(fwtypes.ARNValue(configured)), testCase.expectReplace; got != want {
+				t.Errorf("replace = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitApplicationUpdatable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses     []mockResponse
+		expectedError string
+	}{
+		"created": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app-1","status":"Created"}`},
+			},
+		},
+		"running": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app-1","status":"Running"}`},
+			},
+		},
+		"stopping": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app-1","status":"Stopping"}`},
+				{body: `{"applicationId":"app-1","status":"Stopped"}`},
+			},
+		},
+		"creating": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app-1","status":"Creating"}`},
+				{body: `{"applicationId":"app-1","status":"Available"}`},
+			},
+		},
+		// A second update applied while the first update's version is still being created.
+		"previous update in flight": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app-1","status":"Available","latestVersion":{"applicationVersion":2,"status":"Creating"}}`},
+				{body: `{"applicationVersion":2,"status":"Available"}`},
+			},
+		},
+		"previous update failed": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app-1","status":"Available","latestVersion":{"applicationVersion":2,"status":"Creating"}}`},
+				{body: `{"applicationVersion":2,"status":"Failed","statusReason":"invalid definition"}`},
+			},
+			expectedError: "invalid definition",
+		},
+		"failed": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app-1","status":"Failed"}`},
+			},
+			expectedError: "cannot update application in state Failed",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			_, err := tfm2.WaitApplicationUpdatable(context.Background(), conn, "app-1", time.Minute)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if got, want := err.Error(), testCase.expectedError; !strings.Contains(got, want) {
+					t.Errorf("error = %q, want to contain %q", got, want)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if got, want := httpClient.requestCount(), len(testCase.responses); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestApplicationDefinitionMissingFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		engineType awstypes.EngineType
+		content    string
+		expected   []string
+	}{
+		"bluage complete": {
+			engineType: awstypes.EngineTypeBluage,
+			content:    `{"definition":{"listeners":[{"port":8196,"type":"http"}],"ba-application":{"app-location":"s3://bucket/app.zip"}}}`,
+		},
+		"bluage without app-location": {
+			engineType: awstypes.EngineTypeBluage,
+			content:    `{"definition":{"listeners":[{"port":8196,"type":"http"}],"ba-application":{}}}`,
+			expected:   []string{"definition.ba-application.app-location"},
+		},
+		"bluage without definition": {
+			engineType: awstypes.EngineTypeBluage,
+			content:    `{"template-version":"2.0"}`,
+			expected:   []string{"definition.listeners", "definition.ba-application.app-location"},
+		},
+		"bluage not JSON": {
+			engineType: awstypes.EngineTypeBluage,
+			content:    `not JSON`,
+		},
+		"microfocus without ba-application": {
+			engineType: awstypes.EngineTypeMicrofocus,
+			content:    `{"definition":{"listeners":[],"dataset-location":{"db-locations":[]}}}`,
+		},
+		"microfocus without definition": {
+			engineType: awstypes.EngineTypeMicrofocus,
+			content:    `{"template-version":"2.0"}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfm2.ApplicationDefinitionMissingFields(testCase.engineType, testCase.content), testCase.expected; !slices.Equal(got, want) {
+				t.Errorf("ApplicationDefinitionMissingFields = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestApplicationDefinitionRequiresRole(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		engineType awstypes.EngineType
+		content    string
+		expected   bool
+	}{
+		"microfocus with secret": {
+			engineType: awstypes.EngineTypeMicrofocus,
+			content:    `{"secret-manager":"arn:aws:secretsmanager:us-west-2:123456789012:secret:test"}`, //lintignore:AWSAT003,AWSAT005
+			expected:   true,
+		},
+		"microfocus with GovCloud secret": {
+			engineType: awstypes.EngineTypeMicrofocus,
+			content:    `{"secret-manager":"arn:aws-us-gov:secretsmanager:us-gov-west-1:123456789012:secret:test"}`, //lintignore:AWSAT003,AWSAT005
+			expected:   true,
+		},
+		"microfocus without secret": {
+			engineType: awstypes.EngineTypeMicrofocus,
+			content:    `{"template-version":"2.0"}`,
+			expected:   false,
+		},
+		"bluage with secret": {
+			engineType: awstypes.EngineTypeBluage,
+			content:    `{"secret-manager":"arn:aws:secretsmanager:us-west-2:123456789012:secret:test"}`, //lintignore:AWSAT003,AWSAT005
+			expected:   false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfm2.ApplicationDefinitionRequiresRole(testCase.engineType, testCase.content), testCase.expected; got != want {
+				t.Errorf("ApplicationDefinitionRequiresRole = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+// finderErrorKind is the kind of error a finder is expected to return.
+type finderErrorKind int
+
+const (
+	finderErrorNone finderErrorKind = iota
+	finderErrorNotFound
+	finderErrorEmptyResult
+	finderErrorOther
+)
+
+// testCheckFinderError checks that a finder returned the expected kind of error.
+// Not found and empty results must both be reported as NotFound, so that callers can remove the resource from state.
+func testCheckFinderError(t *testing.T, err error, expected finderErrorKind) {
+	t.Helper()
+
+	switch expected {
+	case finderErrorNone:
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	case finderErrorNotFound:
+		if !tfresource.NotFound(err) {
+			t.Fatalf("expected NotFound error, got %v", err)
+		}
+		if !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			t.Errorf("expected wrapped ResourceNotFoundException, got %v", err)
+		}
+	case finderErrorEmptyResult:
+		if !tfresource.NotFound(err) {
+			t.Fatalf("expected NotFound error, got %v", err)
+		}
+		if !errors.Is(err, tfresource.ErrEmptyResult) {
+			t.Errorf("expected empty result error, got %v", err)
+		}
+	case finderErrorOther:
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if tfresource.NotFound(err) {
+			t.Errorf("expected error to be passed through, got NotFound error %v", err)
+		}
+		if !errs.IsA[*awstypes.AccessDeniedException](err) {
+			t.Errorf("expected AccessDeniedException, got %v", err)
+		}
+	}
+}
+
+func TestFindApplicationByID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		response    mockResponse
+		expectedErr finderErrorKind
+	}{
+		"found": {
+			response: mockResponse{body: `{"applicationId":"app","name":"test","status":"Available"}`},
+		},
+		"not found": {
+			response:    mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "application not found"),
+			expectedErr: finderErrorNotFound,
+		},
+		"empty result": {
+			response:    mockResponse{body: `{}`},
+			expectedErr: finderErrorEmptyResult,
+		},
+		"other error": {
+			response:    mockErrorResponse(http.StatusForbidden, "AccessDeniedException", "not authorized"),
+			expectedErr: finderErrorOther,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.response)
+
+			output, err := tfm2.FindApplicationByID(context.Background(), conn, "app")
+
+			testCheckFinderError(t, err, testCase.expectedErr)
+
+			if got, want := httpClient.requestCount(), 1; got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			if testCase.expectedErr != finderErrorNone {
+				if output != nil {
+					t.Errorf("output = %v, want nil", output)
+				}
+
+				return
+			}
+
+			if got, want := aws.ToString(output.ApplicationId), "app"; got != want {
+				t.Errorf("ApplicationId = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFindApplicationVersionByTwoPartKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		response    mockResponse
+		expectedErr finderErrorKind
+	}{
+		"found": {
+			response: mockResponse{body: `{"applicationVersion":2,"definitionContent":"{}","status":"Available"}`},
+		},
+		"not found": {
+			response:    mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "application version not found"),
+			expectedErr: finderErrorNotFound,
+		},
+		"empty result": {
+			response:    mockResponse{body: `{}`},
+			expectedErr: finderErrorEmptyResult,
+		},
+		"other error": {
+			response:    mockErrorResponse(http.StatusForbidden, "AccessDeniedException", "not authorized"),
+			expectedErr: finderErrorOther,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.response)
+
+			output, err := tfm2.FindApplicationVersionByTwoPartKey(context.Background(), conn, "app", 2)
+
+			testCheckFinderError(t, err, testCase.expectedErr)
+
+			if got, want := httpClient.requestCount(), 1; got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			if got, want := httpClient.requests[0].URL.Path, "/applications/app/versions/2"; got != want {
+				t.Errorf("path = %q, want %q", got, want)
+			}
+
+			if testCase.expectedErr != finderErrorNone {
+				if output != nil {
+					t.Errorf("output = %v, want nil", output)
+				}
+
+				return
+			}
+
+			if got, want := aws.ToInt32(output.ApplicationVersion), int32(2); got != want {
+				t.Errorf("ApplicationVersion = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestFlattenApplicationARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		body      string
+		partition string
+		region    string
+		expected  string
+	}{
+		"returned": {
+			body:      `{"applicationArn":"arn:aws:m2:us-west-2:123456789012:app/app-1","applicationId":"app-1"}`, //lintignore:AWSAT003,AWSAT005
+			partition: "aws",
+			region:    "us-west-2",                                   //lintignore:AWSAT003
+			expected:  "arn:aws:m2:us-west-2:123456789012:app/app-1", //lintignore:AWSAT003,AWSAT005
+		},
+		"omitted": {
+			body:      `{"applicationId":"app-1"}`,
+			partition: "aws",
+			region:    "us-west-2",                                   //lintignore:AWSAT003
+			expected:  "arn:aws:m2:us-west-2:123456789012:app/app-1", //lintignore:AWSAT003,AWSAT005
+		},
+		"omitted in other partition": {
+			body:      `{"applicationId":"app-1"}`,
+			partition: "aws-cn",
+			region:    "cn-north-1",                                      //lintignore:AWSAT003
+			expected:  "arn:aws-cn:m2:cn-north-1:123456789012:app/app-1", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, _ := newMockClient(t, mockResponse{body: testCase.body})
+
+			output, err := tfm2.FindApplicationByID(context.Background(), conn, "app-1")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			client := &conns.AWSClient{
+				AccountID: "123456789012",
+				Partition: testCase.partition,
+				Region:    testCase.region,
+			}
+
+			if got, want := tfm2.FlattenApplicationARN(client, output).ValueString(), testCase.expected; got != want {
+				t.Errorf("ARN = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFindApplicationByIDSettingTagsOut(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t, mockResponse{
+		body: `{"applicationId":"app","status":"Available","tags":{"key1":"value1"}}`,
+	})
+
+	ctx := tftags.NewContext(context.Background(), nil, nil)
+
+	_, err := tfm2.FindApplicationByIDSettingTagsOut(ctx, conn, "app")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Any ListTagsForResource call would have been served by the mock.
+	if got, want := httpClient.requestCount(), 1; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+
+	inContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		t.Fatal("tags not in Context")
+	}
+
+	if inContext.TagsOut.IsNone() {
+		t.Fatal("TagsOut not set, transparent tagging would call ListTagsForResource")
+	}
+
+	if got, want := inContext.TagsOut.MustUnwrap().Map(), map[string]string{"key1": "value1"}; !maps.Equal(got, want) {
+		t.Errorf("tags = %v, want %v", got, want)
+	}
+}
+
+func TestFindDeployedEnvironmentIDsByApplicationID(t *testing.T) {
+	t.Parallel()
+
+	conn, _ := newMockClient(t,
+		mockResponse{body: `{"deployments":[` +
+			`{"applicationId":"app-1","deploymentId":"dep-1","environmentId":"env-2","status":"Succeeded"},` +
+			`{"applicationId":"app-1","deploymentId":"dep-2","environmentId":"env-1","status":"Deploying"},` +
+			`{"applicationId":"app-1","deploymentId":"dep-3","environmentId":"env-3","status":"Failed"},` +
+			`{"applicationId":"app-1","deploymentId":"dep-4","environmentId":"env-2","status":"Updating Deployment"}` +
+			`]}`},
+	)
+
+	output, err := tfm2.FindDeployedEnvironmentIDsByApplicationID(context.Background(), conn, "app-1")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := output, []string{"env-1", "env-2"}; !slices.Equal(got, want) {
+		t.Errorf("environment IDs = %v, want %v", got, want)
+	}
+}
+
+func TestFlattenDeployedEnvironmentID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		environmentIDs []string
+		expected       types.String
+	}{
+		{
+			name:     "not deployed",
+			expected: types.StringNull(),
+		},
+		{
+			name:           "single deployment",
+			environmentIDs: []string{"env-1"},
+			expected:       types.StringValue("env-1"),
+		},
+		{
+			name:           "multiple deployments",
+			environmentIDs: []string{"env-1", "env-2"},
+			expected:       types.StringNull(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfm2.FlattenDeployedEnvironmentID(testCase.environmentIDs), testCase.expected; !got.Equal(want) {
+				t.Errorf("FlattenDeployedEnvironmentID(%v) = %s, want %s", testCase.environmentIDs, got, want)
+			}
+		})
+	}
+}
+
+func TestWaitApplicationCreatedIfReady(t *testing.T) {
+	t.Parallel()
+
+	const timeout = 1 * time.Second
+
+	testCases := map[string]struct {
+		waitForReady bool
+		expectError  bool
+	}{
+		"wait": {
+			waitForReady: true,
+			expectError:  true,
+		},
+		"don't wait": {
+			waitForReady: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, mockResponse{body: `{"applicationId":"app","status":"Creating"}`})
+
+			start := time.Now()
+			output, err := tfm2.WaitApplicationCreatedIfReady(context.Background(), conn, "app", testCase.waitForReady, timeout)
+			elapsed := time.Since(start)
+
+			if got, want := httpClient.requestCount(), 1; got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			if testCase.expectError {
+				if !tfresource.TimedOut(err) {
+					t.Fatalf("error = %v, want timeout", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if elapsed >= timeout {
+				t.Errorf("elapsed = %s, want less than %s", elapsed, timeout)
+			}
+
+			if got, want := output.Status, awstypes.ApplicationLifecycleCreating; got != want {
+				t.Errorf("Status = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitApplicationPollInterval(t *testing.T) {
+	t.Parallel()
+
+	const timeout = 1 * time.Second
+
+	testCases := map[string]struct {
+		response mockResponse
+		wait     func(context.Context, *m2.Client) error
+	}{
+		"created": {
+			response: mockResponse{body: `{"applicationId":"app","status":"Creating"}`},
+			wait: func(ctx context.Context, conn *m2.Client) error {
+				_, err := tfm2.WaitApplicationCreated(ctx, conn, "app", timeout)
+				return err
+			},
+		},
+		"updated": {
+			response: mockResponse{body: `{"applicationVersion":2,"status":"Creating"}`},
+			wait: func(ctx context.Context, conn *m2.Client) error {
+				_, err := tfm2.WaitApplicationUpdated(ctx, conn, "app", 2, timeout)
+				return err
+			},
+		},
+		"deleted": {
+			response: mockResponse{body: `{"applicationId":"app","status":"Deleting"}`},
+			wait: func(ctx context.Context, conn *m2.Client) error {
+				_, err := tfm2.WaitApplicationDeleted(ctx, conn, "app", timeout)
+				return err
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.response)
+
+			start := time.Now()
+			err := testCase.wait(context.Background(), conn)
+			elapsed := time.Since(start)
+
+			if !tfresource.TimedOut(err) {
+				t.Fatalf("error = %v, want timeout", err)
+			}
+
+			// The minimum poll interval is longer than the timeout, so only the first refresh is made.
+			if got, want := httpClient.requestCount(), 1; got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			if elapsed > 2*timeout {
+				t.Errorf("elapsed = %s, want at most %s", elapsed, 2*timeout)
+			}
+		})
+	}
+}
+
+func TestStatusApplicationVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		response           mockResponse
+		propagationTimeout time.Duration
+		expectedStatus     string
+		expectNotFound     bool
+	}{
+		"creating": {
+			response:           mockResponse{body: `{"applicationVersion":2,"status":"Creating"}`},
+			propagationTimeout: time.Minute,
+			expectedStatus:     string(awstypes.ApplicationVersionLifecycleCreating),
+		},
+		"not found within propagation timeout": {
+			response:           mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "version not found"),
+			propagationTimeout: time.Minute,
+		},
+		"not found after propagation timeout": {
+			response:       mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "version not found"),
+			expectNotFound: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, _ := newMockClient(t, testCase.response)
+
+			output, status, err := tfm2.StatusApplicationVersion(context.Background(), conn, "app", 2, testCase.propagationTimeout)()
+
+			if testCase.expectNotFound {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected NotFound error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := status, testCase.expectedStatus; got != want {
+				t.Errorf("status = %q, want %q", got, want)
+			}
+
+			if testCase.expectedStatus == "" && output != nil {
+				t.Errorf("output = %v, want nil", output)
+			}
+		})
+	}
+}
+
+// TestWaitApplicationUpdated_initialNotFound verifies that a new version that isn't yet visible to GetApplicationVersion doesn't fail the update.
+func TestWaitApplicationUpdated_initialNotFound(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "version not found"),
+		mockResponse{body: `{"applicationVersion":2,"status":"Available"}`},
+	)
+
+	output, err := tfm2.WaitApplicationUpdated(context.Background(), conn, "app", 2, 30*time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := output.Status, awstypes.ApplicationVersionLifecycleAvailable; got != want {
+		t.Errorf("status = %q, want %q", got, want)
+	}
+
+	if got, want := httpClient.requestCount(), 2; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestWaitApplicationDeleted_environmentNotFound(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{"applicationId":"app","status":"Deleting"}`},
+		mockResponse{
+			statusCode: http.StatusNotFound,
+			errorType:  "ResourceNotFoundException",
+			body:       `{"message":"environment not found","resourceId":"env","resourceType":"environment"}`,
+		},
+	)
+
+	if _, err := tfm2.WaitApplicationDeleted(context.Background(), conn, "app", 30*time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := httpClient.requestCount(), 2; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestWaitApplicationDeletedFromEnvironment_environmentNotFound(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{"applicationId":"app","status":"Deleting From Environment"}`},
+		mockResponse{
+			statusCode: http.StatusNotFound,
+			errorType:  "ResourceNotFoundException",
+			body:       `{"message":"environment not found","resourceId":"env","resourceType":"environment"}`,
+		},
+	)
+
+	if _, err := tfm2.WaitApplicationDeletedFromEnvironment(context.Background(), conn, "app", 30*time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := httpClient.requestCount(), 2; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func testAccCheckApplicationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_m2_application" {
+				continue
+			}
+
+			_, err := tfm2.FindApplicationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Mainframe Modernization Application %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckApplicationUpdateDescription changes the application's description outside of Terraform.
+func testAccCheckApplicationUpdateDescription(ctx context.Context, v *m2.GetApplicationOutput, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
+
+		_, err := tfm2.UpdateApplicationDescription(ctx, conn, aws.ToString(v.ApplicationId), aws.ToInt32(v.LatestVersion.ApplicationVersion), aws.String(description), 30*time.Minute)
+
+		return err
+	}
+}
+
+func testAccApplicationImportStateIDWithS3LocationFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.ID + "," + rs.Primary.Attributes["definition.0.normalized_s3_location"], nil
+	}
+}
+
+func testAccCheckApplicationExists(ctx context.Context, n string, v *m2.GetApplicationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
+
+		output, err := tfm2.FindApplicationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckApplicationUpdateTags(ctx context.Context, v *m2.GetApplicationOutput, oldTags, newTags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
+
+		return tfm2.UpdateTags(ctx, conn, aws.ToString(v.ApplicationArn), oldTags, newTags)
+	}
+}
+
+func testAccApplicationPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
+
+	input := &m2.ListApplicationsInput{}
+	_, err := conn.ListApplications(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccApplicationConfig_basic(rName, engineType string) string {
+	return testAccApplicationConfig_versioned(rName, engineType, 1, 1)
+}
+
+// testAccApplicationDefinitionContentFile renders the application definition fixture to a temporary file.
+func testAccApplicationDefinitionContentFile(t *testing.T, rName string, version int) string {
+	t.Helper()
+
+	b, err := os.ReadFile("test-fixtures/application-definition.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := strings.NewReplacer(
+		"${s3_bucket}", rName,
+		"${version}", strconv.Itoa(version),
+		"$${", "${",
+	).Replace(string(b))
+
+	name := filepath.Join(t.TempDir(), "application-definition.json")
+	if err := os.WriteFile(name, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return name
+}
+
+func testAccApplicationConfig_contentFile(rName, contentFile string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "v1/PlanetsDemo-v1.zip"
+  source = "test-fixtures/PlanetsDemo-v1.zip"
+}
+
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+  definition {
+    content_file = %[2]q
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName, contentFile)
+}
+
+func testAccApplicationConfig_contentBase64(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "v1/PlanetsDemo-v1.zip"
+  source = "test-fixtures/PlanetsDemo-v1.zip"
+}
+
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+  definition {
+    content_base64 = base64encode(templatefile("test-fixtures/application-definition.json", { s3_bucket = aws_s3_bucket.test.id, version = 1 }))
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName)
+}
+
+func testAccApplicationConfig_versioned(rName, engineType string, version, versions int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  count = %[4]d
+
+  bucket = aws_s3_bucket.test.id
+  key    = "v${count.index + 1}/PlanetsDemo-v${count.index + 1}.zip"
+  source = "test-fixtures/PlanetsDemo-v1.zip"
+}
+
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = %[2]q
+  definition {
+    content = templatefile("test-fixtures/application-definition.json", { s3_bucket = aws_s3_bucket.test.id, version = %[3]d })
+  }
 
   depends_on = [aws_s3_object.test]
 }
 `, rName, engineType, version, versions)
 }
 
+func testAccApplicationConfig_multipleRegions(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "v1/PlanetsDemo-v1.zip"
+  source = "test-fixtures/PlanetsDemo-v1.zip"
+}
+
+locals {
+  definition = templatefile("test-fixtures/application-definition.json", { s3_bucket = aws_s3_bucket.test.id, version = 1 })
+}
+
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+  definition {
+    content = local.definition
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+
+resource "aws_m2_application" "alternate" {
+  provider = "awsalternate"
+
+  name        = %[1]q
+  engine_type = "bluage"
+  definition {
+    content = local.definition
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName))
+}
+
+func testAccApplicationConfig_description(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "v1/PlanetsDemo-v1.zip"
+  source = "test-fixtures/PlanetsDemo-v1.zip"
+}
+
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+  description = %[2]q
+  definition {
+    content = templatefile("test-fixtures/application-definition.json", { s3_bucket = aws_s3_bucket.test.id, version = 1 })
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName, description)
+}
+
+func testAccApplicationConfig_deletionProtection(rName string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "v1/PlanetsDemo-v1.zip"
+  source = "test-fixtures/PlanetsDemo-v1.zip"
+}
+
+resource "aws_m2_application" "test" {
+  name                = %[1]q
+  engine_type         = "bluage"
+  deletion_protection = %[2]t
+  definition {
+    content = templatefile("test-fixtures/application-definition.json", { s3_bucket = aws_s3_bucket.test.id, version = 1 })
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName, deletionProtection)
+}
+
+func testAccApplicationConfig_triggerVersionReplacement(rName, build string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "v1/PlanetsDemo-v1.zip"
+  source = "test-fixtures/PlanetsDemo-v1.zip"
+}
+
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+  definition {
+    content = templatefile("test-fixtures/application-definition.json", { s3_bucket = aws_s3_bucket.test.id, version = 1 })
+  }
+
+  trigger_version_replacement = {
+    build = %[2]q
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName, build)
+}
+
+func testAccApplicationConfig_stagingBucket(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "v1/PlanetsDemo-v1.zip"
+  source = "test-fixtures/PlanetsDemo-v1.zip"
+}
+
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+  definition {
+    # Pad the definition past the 65000 byte inline limit.
+    content = jsonencode(merge(
+      jsondecode(templatefile("test-fixtures/application-definition.json", { s3_bucket = aws_s3_bucket.test.id, version = 1 })),
+      { "padding" = join("", [for i in range(7000) : "0123456789"]) },
+    ))
+    staging_bucket = aws_s3_bucket.test.id
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName)
+}
+
+func testAccApplicationConfig_secretsManagerReference(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "microfocus"
+  definition {
+    content = jsonencode({
+      "template-version" = "2.0"
+      "source-locations" = []
+      "definition" = {
+        "listeners" = []
+        "dataset-location" = {
+          "db-locations" = [{
+            "name"           = "Database1"
+            "secret-manager" = "arn:${data.aws_partition.current.partition}:secretsmanager:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:secret:%[1]s"
+          }]
+        }
+      }
+    })
+  }
+}
+`, rName)
+}
+
+func testAccApplicationConfig_s3ObjectVersion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "v1/PlanetsDemo-v1.zip"
+  source = "test-fixtures/PlanetsDemo-v1.zip"
+}
+
+resource "aws_s3_object" "definition" {
+  bucket  = aws_s3_bucket_versioning.test.id
+  key     = "definition.json"
+  content = templatefile("test-fixtures/application-definition.json", { s3_bucket = aws_s3_bucket.test.id, version = "v1" })
+}
+
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+  definition {
+    s3_location       = "s3://${aws_s3_object.definition.bucket}/${aws_s3_object.definition.key}"
+    s3_object_version = aws_s3_object.definition.version_id
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName)
+}
+
+func testAccApplicationConfig_s3BucketAndKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "v1/PlanetsDemo-v1.zip"
+  source = "test-fixtures/PlanetsDemo-v1.zip"
+}
+
+resource "aws_s3_object" "definition" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "definition.json"
+  content = templatefile("test-fixtures/application-definition.json", { s3_bucket = aws_s3_bucket.test.id, version = "v1" })
+}
+
+resource "aws_m2_application" "test" {
+  name        = %[1]q
+  engine_type = "bluage"
+  definition {
+    s3_bucket = aws_s3_object.definition.bucket
+    s3_key    = aws_s3_object.definition.key
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName)
+}
+
 func testAccApplicationConfig_full(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Application Version")
+func newApplicationVersionDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &applicationVersionDataSource{}, nil
+}
+
+type applicationVersionDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*applicationVersionDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_m2_application_version"
+}
+
+func (d *applicationVersionDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+			},
+			"application_version": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, math.MaxInt32),
+				},
+			},
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"definition_content": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApplicationVersionLifecycle](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *applicationVersionDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data applicationVersionDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().M2Client(ctx)
+
+	applicationID := data.ApplicationID.ValueString()
+	version, err := expandApplicationVersion(data.ApplicationVersion)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s) version", applicationID), err.Error())
+
+		return
+	}
+
+	output, err := findApplicationVersionByTwoPartKey(ctx, conn, applicationID, version)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s) version (%d)", applicationID, version), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s%s%d", applicationID, applicationImportIDSeparator, version))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type applicationVersionDataSourceModel struct {
+	ApplicationID      types.String                                             `tfsdk:"application_id"`
+	ApplicationVersion types.Int64                                              `tfsdk:"application_version"`
+	CreationTime       timetypes.RFC3339                                        `tfsdk:"creation_time"`
+	DefinitionContent  types.String                                             `tfsdk:"definition_content"`
+	ID                 types.String                                             `tfsdk:"id"`
+	Status             fwtypes.StringEnum[awstypes.ApplicationVersionLifecycle] `tfsdk:"status"`
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Applications")
+func newApplicationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &applicationsDataSource{}, nil
+}
+
+type applicationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*applicationsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_m2_applications"
+}
+
+func (d *applicationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"applications": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[applicationSummaryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						names.AttrApplicationID: types.StringType,
+						names.AttrARN:           types.StringType,
+						"engine_type":           fwtypes.StringEnumType[awstypes.EngineType](),
+						names.AttrName:          types.StringType,
+						names.AttrStatus:        fwtypes.StringEnumType[awstypes.ApplicationLifecycle](),
+					},
+				},
+			},
+			"engine_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EngineType](),
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrNamePrefix: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (d *applicationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data applicationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().M2Client(ctx)
+
+	output, err := findApplicationsByEngineTypeAndNamePrefix(ctx, conn, data.EngineType.ValueEnum(), data.NamePrefix.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Mainframe Modernization Applications", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Applications)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findApplicationsByEngineTypeAndNamePrefix returns all applications, optionally filtered by engine type and name prefix.
+// The API supports neither filter, so both are applied client-side.
+func findApplicationsByEngineTypeAndNamePrefix(ctx context.Context, conn *m2.Client, engineType awstypes.EngineType, namePrefix string) ([]awstypes.ApplicationSummary, error) {
+	applications, err := findApplications(ctx, conn, &m2.ListApplicationsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []awstypes.ApplicationSummary
+
+	for _, application := range applications {
+		if engineType != "" && application.EngineType != engineType {
+			continue
+		}
+
+		if namePrefix != "" && !strings.HasPrefix(aws.ToString(application.Name), namePrefix) {
+			continue
+		}
+
+		output = append(output, application)
+	}
+
+	return output, nil
+}
+
+type applicationsDataSourceModel struct {
+	Applications fwtypes.ListNestedObjectValueOf[applicationSummaryModel] `tfsdk:"applications"`
+	EngineType   fwtypes.StringEnum[awstypes.EngineType]                  `tfsdk:"engine_type"`
+	ID           types.String                                             `tfsdk:"id"`
+	NamePrefix   types.String                                             `tfsdk:"name_prefix"`
+}
+
+type applicationSummaryModel struct {
+	ApplicationARN types.String                                      `tfsdk:"arn"`
+	ApplicationID  types.String                                      `tfsdk:"application_id"`
+	EngineType     fwtypes.StringEnum[awstypes.EngineType]           `tfsdk:"engine_type"`
+	Name           types.String                                      `tfsdk:"name"`
+	Status         fwtypes.StringEnum[awstypes.ApplicationLifecycle] `tfsdk:"status"`
+}
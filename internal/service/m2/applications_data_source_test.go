@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccM2ApplicationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_m2_applications.test"
+	resourceName := "aws_m2_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccApplicationPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "applications.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "applications.0.application_id", resourceName, names.AttrApplicationID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "applications.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "applications.0.engine_type", "bluage"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "applications.0.name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "applications.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindApplicationsByEngineTypeAndNamePrefix(t *testing.T) {
+	t.Parallel()
+
+	const body = `{"applications":[` +
+		`{"applicationId":"app-1","engineType":"bluage","name":"inventory-a"},` +
+		`{"applicationId":"app-2","engineType":"microfocus","name":"inventory-b"},` +
+		`{"applicationId":"app-3","engineType":"bluage","name":"payroll"}` +
+		`]}`
+
+	testCases := []struct {
+		name       string
+		engineType awstypes.EngineType
+		namePrefix string
+		expected   []string
+	}{
+		{
+			name:     "no filters",
+			expected: []string{"app-1", "app-2", "app-3"},
+		},
+		{
+			name:       "engine type",
+			engineType: awstypes.EngineTypeBluage,
+			expected:   []string{"app-1", "app-3"},
+		},
+		{
+			name:       "name prefix",
+			namePrefix: "inventory-",
+			expected:   []string{"app-1", "app-2"},
+		},
+		{
+			name:       "engine type and name prefix",
+			engineType: awstypes.EngineTypeMicrofocus,
+			namePrefix: "inventory-",
+			expected:   []string{"app-2"},
+		},
+		{
+			name:       "no match",
+			namePrefix: "billing",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, _ := newMockClient(t, mockResponse{body: body})
+
+			output, err := tfm2.FindApplicationsByEngineTypeAndNamePrefix(context.Background(), conn, testCase.engineType, testCase.namePrefix)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, application := range output {
+				got = append(got, aws.ToString(application.ApplicationId))
+			}
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("applications = %v, want %v", got, testCase.expected)
+			}
+
+			for i := range got {
+				if got[i] != testCase.expected[i] {
+					t.Errorf("applications = %v, want %v", got, testCase.expected)
+
+					break
+				}
+			}
+		})
+	}
+}
+
+func testAccApplicationsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName, "bluage"), `
+data "aws_m2_applications" "test" {
+  engine_type = aws_m2_application.test.engine_type
+  name_prefix = aws_m2_application.test.name
+}
+`)
+}
@@ -0,0 +1,479 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Batch Job Execution")
+func newBatchJobExecutionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &batchJobExecutionResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type batchJobExecutionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (*batchJobExecutionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_m2_batch_job_execution"
+}
+
+func (r *batchJobExecutionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"execution_id": framework.IDAttribute(),
+			names.AttrID:   framework.IDAttribute(),
+			"job_params": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BatchJobExecutionStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"batch_job_identifier": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[batchJobIdentifierModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"file_name": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("file_name"),
+									path.MatchRelative().AtParent().AtName("script_name"),
+								),
+							},
+						},
+						"folder_path": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.AlsoRequires(
+									path.MatchRelative().AtParent().AtName("file_name"),
+								),
+							},
+						},
+						"script_name": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *batchJobExecutionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data batchJobExecutionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	input := &m2.StartBatchJobInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	batchJobIdentifierData, diags := data.BatchJobIdentifier.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.BatchJobIdentifier = expandBatchJobIdentifier(batchJobIdentifierData)
+
+	output, err := conn.StartBatchJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting Mainframe Modernization Application (%s) batch job", data.ApplicationID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ExecutionID = fwflex.StringToFramework(ctx, output.ExecutionId)
+	data.setID()
+
+	execution, err := waitBatchJobExecutionCompleted(ctx, conn, data.ApplicationID.ValueString(), data.ExecutionID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Batch Job Execution (%s) complete", data.ID.ValueString()), err.Error())
+
+		// Don't leave a timed out execution running.
+		if errs.IsA[*retry.TimeoutError](err) {
+			response.Diagnostics.Append(cancelTimedOutBatchJobExecution(ctx, conn, data.ApplicationID.ValueString(), data.ExecutionID.ValueString())...)
+		}
+
+		return
+	}
+
+	data.Status = fwtypes.StringEnumValue(execution.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *batchJobExecutionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data batchJobExecutionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	output, err := findBatchJobExecutionByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.ExecutionID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Batch Job Execution (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	if batchJobIdentifierData := flattenBatchJobIdentifier(output.BatchJobIdentifier); batchJobIdentifierData != nil {
+		data.BatchJobIdentifier = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, batchJobIdentifierData)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *batchJobExecutionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data batchJobExecutionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Completed executions can't be deleted, only removed from state.
+	if status := data.Status.ValueEnum(); !batchJobExecutionStatusInProgress(status) {
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	err := cancelBatchJobExecution(ctx, conn, data.ApplicationID.ValueString(), data.ExecutionID.ValueString())
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("cancelling Mainframe Modernization Batch Job Execution (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitBatchJobExecutionCancelled(ctx, conn, data.ApplicationID.ValueString(), data.ExecutionID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Batch Job Execution (%s) cancel", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func cancelBatchJobExecution(ctx context.Context, conn *m2.Client, applicationID, executionID string) error {
+	_, err := conn.CancelBatchJobExecution(ctx, &m2.CancelBatchJobExecutionInput{
+		ApplicationId: aws.String(applicationID),
+		ExecutionId:   aws.String(executionID),
+	})
+
+	return err
+}
+
+// cancelTimedOutBatchJobExecution requests cancellation of an execution that didn't complete before the create timeout.
+// Cancellation isn't waited for; the execution is removed from state when the tainted resource is replaced.
+func cancelTimedOutBatchJobExecution(ctx context.Context, conn *m2.Client, applicationID, executionID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id := errs.Must(flex.FlattenResourceId([]string{applicationID, executionID}, batchJobExecutionResourceIDPartCount, false))
+	err := cancelBatchJobExecution(ctx, conn, applicationID, executionID)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("cancelling timed out Mainframe Modernization Batch Job Execution (%s)", id), err.Error())
+
+		return diags
+	}
+
+	diags.AddWarning(
+		"Batch Job Execution Cancelled",
+		fmt.Sprintf("Mainframe Modernization Batch Job Execution (%s) didn't complete before the create timeout and has been cancelled.", id),
+	)
+
+	return diags
+}
+
+func findBatchJobExecutionByTwoPartKey(ctx context.Context, conn *m2.Client, applicationID, executionID string) (*m2.GetBatchJobExecutionOutput, error) {
+	input := &m2.GetBatchJobExecutionInput{
+		ApplicationId: aws.String(applicationID),
+		ExecutionId:   aws.String(executionID),
+	}
+
+	output, err := conn.GetBatchJobExecution(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusBatchJobExecution(ctx context.Context, conn *m2.Client, applicationID, executionID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBatchJobExecutionByTwoPartKey(ctx, conn, applicationID, executionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+var (
+	batchJobExecutionInProgressStatuses = []awstypes.BatchJobExecutionStatus{
+		awstypes.BatchJobExecutionStatusSubmitting,
+		awstypes.BatchJobExecutionStatusHolding,
+		awstypes.BatchJobExecutionStatusDispatch,
+		awstypes.BatchJobExecutionStatusRunning,
+	}
+)
+
+func batchJobExecutionStatusInProgress(status awstypes.BatchJobExecutionStatus) bool {
+	return slices.Contains(batchJobExecutionInProgressStatuses, status)
+}
+
+func waitBatchJobExecutionCompleted(ctx context.Context, conn *m2.Client, applicationID, executionID string, timeout time.Duration) (*m2.GetBatchJobExecutionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(batchJobExecutionInProgressStatuses...),
+		Target:  enum.Slice(awstypes.BatchJobExecutionStatusSucceeded, awstypes.BatchJobExecutionStatusSucceededWithWarning),
+		Refresh: statusBatchJobExecution(ctx, conn, applicationID, executionID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetBatchJobExecutionOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBatchJobExecutionCancelled(ctx context.Context, conn *m2.Client, applicationID, executionID string, timeout time.Duration) (*m2.GetBatchJobExecutionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(append(batchJobExecutionInProgressStatuses, awstypes.BatchJobExecutionStatusCancelling)...),
+		Target: enum.Slice(
+			awstypes.BatchJobExecutionStatusCancelled,
+			awstypes.BatchJobExecutionStatusFailed,
+			awstypes.BatchJobExecutionStatusPurged,
+			awstypes.BatchJobExecutionStatusSucceeded,
+			awstypes.BatchJobExecutionStatusSucceededWithWarning,
+		),
+		Refresh: statusBatchJobExecution(ctx, conn, applicationID, executionID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetBatchJobExecutionOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type batchJobExecutionResourceModel struct {
+	ApplicationID      types.String                                             `tfsdk:"application_id"`
+	BatchJobIdentifier fwtypes.ListNestedObjectValueOf[batchJobIdentifierModel] `tfsdk:"batch_job_identifier"`
+	ExecutionID        types.String                                             `tfsdk:"execution_id"`
+	ID                 types.String                                             `tfsdk:"id"`
+	JobParams          fwtypes.MapValueOf[types.String]                         `tfsdk:"job_params"`
+	Status             fwtypes.StringEnum[awstypes.BatchJobExecutionStatus]     `tfsdk:"status"`
+	Timeouts           timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+const (
+	batchJobExecutionResourceIDPartCount = 2
+)
+
+func (data *batchJobExecutionResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, batchJobExecutionResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ApplicationID = types.StringValue(parts[0])
+	data.ExecutionID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *batchJobExecutionResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ApplicationID.ValueString(), data.ExecutionID.ValueString()}, batchJobExecutionResourceIDPartCount, false)))
+}
+
+type batchJobIdentifierModel struct {
+	FileName   types.String `tfsdk:"file_name"`
+	FolderPath types.String `tfsdk:"folder_path"`
+	ScriptName types.String `tfsdk:"script_name"`
+}
+
+func expandBatchJobIdentifier(batchJobIdentifierData *batchJobIdentifierModel) awstypes.BatchJobIdentifier {
+	if batchJobIdentifierData == nil {
+		return nil
+	}
+
+	if !batchJobIdentifierData.FileName.IsNull() {
+		return &awstypes.BatchJobIdentifierMemberFileBatchJobIdentifier{
+			Value: awstypes.FileBatchJobIdentifier{
+				FileName:   batchJobIdentifierData.FileName.ValueStringPointer(),
+				FolderPath: batchJobIdentifierData.FolderPath.ValueStringPointer(),
+			},
+		}
+	}
+
+	if !batchJobIdentifierData.ScriptName.IsNull() {
+		return &awstypes.BatchJobIdentifierMemberScriptBatchJobIdentifier{
+			Value: awstypes.ScriptBatchJobIdentifier{
+				ScriptName: batchJobIdentifierData.ScriptName.ValueStringPointer(),
+			},
+		}
+	}
+
+	return nil
+}
+
+func flattenBatchJobIdentifier(apiObject awstypes.BatchJobIdentifier) *batchJobIdentifierModel {
+	switch v := apiObject.(type) {
+	case *awstypes.BatchJobIdentifierMemberFileBatchJobIdentifier:
+		return &batchJobIdentifierModel{
+			FileName:   types.StringPointerValue(v.Value.FileName),
+			FolderPath: types.StringPointerValue(v.Value.FolderPath),
+			ScriptName: types.StringNull(),
+		}
+
+	case *awstypes.BatchJobIdentifierMemberScriptBatchJobIdentifier:
+		return &batchJobIdentifierModel{
+			FileName:   types.StringNull(),
+			FolderPath: types.StringNull(),
+			ScriptName: types.StringPointerValue(v.Value.ScriptName),
+		}
+	}
+
+	return nil
+}
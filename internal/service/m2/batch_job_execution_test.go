@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccM2BatchJobExecution_jobParams(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	// The name of a batch job script contained in the test application.
+	scriptName := acctest.SkipIfEnvVarNotSet(t, "M2_BATCH_JOB_SCRIPT_NAME")
+	var execution m2.GetBatchJobExecutionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_batch_job_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchJobExecutionConfig_jobParams(rName, scriptName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchJobExecutionExists(ctx, resourceName, &execution),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, "aws_m2_deployment.test", names.AttrApplicationID),
+					resource.TestCheckResourceAttr(resourceName, "batch_job_identifier.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "batch_job_identifier.0.script_name", scriptName),
+					resource.TestCheckResourceAttrSet(resourceName, "execution_id"),
+					resource.TestCheckResourceAttr(resourceName, "job_params.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "job_params.RUN_MODE", "test"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Succeeded"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"job_params"},
+			},
+		},
+	})
+}
+
+func TestBatchJobExecutionResourceModelInitFromID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id                string
+		expectError       bool
+		expectAppID       string
+		expectExecutionID string
+	}{
+		"valid": {
+			id:                "app,exec",
+			expectAppID:       "app",
+			expectExecutionID: "exec",
+		},
+		"one part": {
+			id:          "app",
+			expectError: true,
+		},
+		"three parts": {
+			id:          "app,exec,extra",
+			expectError: true,
+		},
+		"empty part": {
+			id:          "app,",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := tfm2.BatchJobExecutionResourceModel{ID: types.StringValue(testCase.id)}
+			err := data.InitFromID()
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if got, want := err.Error(), testCase.id; !strings.Contains(got, want) {
+					t.Errorf("error = %q, want to contain %q", got, want)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := data.ApplicationID.ValueString(), testCase.expectAppID; got != want {
+				t.Errorf("ApplicationID = %q, want %q", got, want)
+			}
+
+			if got, want := data.ExecutionID.ValueString(), testCase.expectExecutionID; got != want {
+				t.Errorf("ExecutionID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitBatchJobExecutionCompleted_failed(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{"applicationId":"app","executionId":"exec","status":"Running"}`},
+		mockResponse{body: `{"applicationId":"app","executionId":"exec","status":"Failed","statusReason":"step STEP01 abended"}`},
+	)
+
+	_, err := tfm2.WaitBatchJobExecutionCompleted(context.Background(), conn, "app", "exec", 30*time.Minute)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := err.Error(), "step STEP01 abended"; !strings.Contains(got, want) {
+		t.Errorf("error = %q, want to contain %q", got, want)
+	}
+
+	if got, want := httpClient.requestCount(), 2; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestWaitBatchJobExecutionCompleted_timeoutCancels(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{"applicationId":"app","executionId":"exec","status":"Running"}`},
+		mockResponse{body: `{}`},
+	)
+
+	_, err := tfm2.WaitBatchJobExecutionCompleted(ctx, conn, "app", "exec", time.Millisecond)
+
+	if !errs.IsA[*retry.TimeoutError](err) {
+		t.Fatalf("error = %v, want timeout error", err)
+	}
+
+	diags := tfm2.CancelTimedOutBatchJobExecution(ctx, conn, "app", "exec")
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := diags.WarningsCount(), 1; got != want {
+		t.Errorf("warnings = %d, want %d", got, want)
+	}
+
+	// The waiter may time out before its first poll, so only the last request is checked.
+	if got, want := httpClient.requests[httpClient.requestCount()-1].URL.Path, "/applications/app/batch-job-executions/exec/cancel"; got != want {
+		t.Errorf("cancel request path = %q, want %q", got, want)
+	}
+}
+
+func testAccCheckBatchJobExecutionExists(ctx context.Context, n string, v *m2.GetBatchJobExecutionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
+
+		output, err := tfm2.FindBatchJobExecutionByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["execution_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBatchJobExecutionConfig_jobParams(rName, scriptName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_basic(rName, "bluage", 1, 1, true), fmt.Sprintf(`
+resource "aws_m2_batch_job_execution" "test" {
+  application_id = aws_m2_deployment.test.application_id
+
+  batch_job_identifier {
+    script_name = %[1]q
+  }
+
+  job_params = {
+    RUN_MODE = "test"
+  }
+}
+`, scriptName))
+}
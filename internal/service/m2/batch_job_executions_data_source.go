@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Batch Job Executions")
+func newBatchJobExecutionsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &batchJobExecutionsDataSource{}, nil
+}
+
+type batchJobExecutionsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*batchJobExecutionsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_m2_batch_job_executions"
+}
+
+func (d *batchJobExecutionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+			},
+			"executions": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[batchJobExecutionSummaryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"end_time":          timetypes.RFC3339Type{},
+						"execution_id":      types.StringType,
+						"job_id":            types.StringType,
+						names.AttrStartTime: timetypes.RFC3339Type{},
+						names.AttrStatus:    fwtypes.StringEnumType[awstypes.BatchJobExecutionStatus](),
+					},
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"started_after": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BatchJobExecutionStatus](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *batchJobExecutionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data batchJobExecutionsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().M2Client(ctx)
+
+	input := &m2.ListBatchJobExecutionsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findBatchJobExecutions(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s) batch job executions", data.ApplicationID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Executions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.ApplicationID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findBatchJobExecutions(ctx context.Context, conn *m2.Client, input *m2.ListBatchJobExecutionsInput) ([]awstypes.BatchJobExecutionSummary, error) {
+	var output []awstypes.BatchJobExecutionSummary
+
+	pages := m2.NewListBatchJobExecutionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.BatchJobExecutions...)
+	}
+
+	return output, nil
+}
+
+type batchJobExecutionsDataSourceModel struct {
+	ApplicationID types.String                                                   `tfsdk:"application_id"`
+	Executions    fwtypes.ListNestedObjectValueOf[batchJobExecutionSummaryModel] `tfsdk:"executions"`
+	ID            types.String                                                   `tfsdk:"id"`
+	StartedAfter  timetypes.RFC3339                                              `tfsdk:"started_after"`
+	Status        fwtypes.StringEnum[awstypes.BatchJobExecutionStatus]           `tfsdk:"status"`
+}
+
+type batchJobExecutionSummaryModel struct {
+	EndTime     timetypes.RFC3339                                    `tfsdk:"end_time"`
+	ExecutionID types.String                                         `tfsdk:"execution_id"`
+	JobID       types.String                                         `tfsdk:"job_id"`
+	StartTime   timetypes.RFC3339                                    `tfsdk:"start_time"`
+	Status      fwtypes.StringEnum[awstypes.BatchJobExecutionStatus] `tfsdk:"status"`
+}
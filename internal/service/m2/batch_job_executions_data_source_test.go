@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccM2BatchJobExecutionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	// The name of a batch job script contained in the test application.
+	scriptName := acctest.SkipIfEnvVarNotSet(t, "M2_BATCH_JOB_SCRIPT_NAME")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_m2_batch_job_executions.test"
+	resourceName := "aws_m2_batch_job_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchJobExecutionsDataSourceConfig_basic(rName, scriptName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrApplicationID, resourceName, names.AttrApplicationID),
+					resource.TestCheckResourceAttr(dataSourceName, "executions.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "executions.0.execution_id", resourceName, "execution_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "executions.0.status", resourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccBatchJobExecutionsDataSourceConfig_basic(rName, scriptName string) string {
+	return acctest.ConfigCompose(testAccBatchJobExecutionConfig_jobParams(rName, scriptName), `
+data "aws_m2_batch_job_executions" "test" {
+  application_id = aws_m2_batch_job_execution.test.application_id
+}
+`)
+}
@@ -8,5 +8,10 @@ import (
 )
 
 const (
-	propagationTimeout = 2 * time.Minute
+	limitExceededTimeout = 5 * time.Minute
+	propagationTimeout   = 2 * time.Minute
+)
+
+const (
+	servicePrincipal = "m2.amazonaws.com"
 )
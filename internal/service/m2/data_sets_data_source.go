@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Data Sets")
+func newDataSetsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSetsDataSource{}, nil
+}
+
+type dataSetsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*dataSetsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_m2_data_sets"
+}
+
+func (d *dataSetsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data_sets": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSetSummaryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"dataset_name": types.StringType,
+						"dataset_org":  types.StringType,
+						"format":       types.StringType,
+						"last_updated": timetypes.RFC3339Type{},
+					},
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrNamePrefix: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSetsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSetsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().M2Client(ctx)
+
+	environmentID := data.EnvironmentID.ValueString()
+	output, err := findDataSetsByEnvironmentIDAndNamePrefix(ctx, conn, environmentID, data.NamePrefix.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Environment (%s) data sets", environmentID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.DataSets)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.EnvironmentID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findDataSetsByEnvironmentIDAndNamePrefix returns the data sets of all applications in the specified environment,
+// optionally filtered by name prefix.
+// Data sets can only be listed per application, so the environment's applications are listed first.
+func findDataSetsByEnvironmentIDAndNamePrefix(ctx context.Context, conn *m2.Client, environmentID, namePrefix string) ([]awstypes.DataSetSummary, error) {
+	applications, err := findApplications(ctx, conn, &m2.ListApplicationsInput{
+		EnvironmentId: aws.String(environmentID),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []awstypes.DataSetSummary
+
+	for _, application := range applications {
+		input := &m2.ListDataSetsInput{
+			ApplicationId: application.ApplicationId,
+		}
+		if namePrefix != "" {
+			input.Prefix = aws.String(namePrefix)
+		}
+
+		dataSets, err := findDataSets(ctx, conn, input)
+
+		// The application may have been deleted since being listed.
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, dataSets...)
+	}
+
+	return output, nil
+}
+
+func findDataSets(ctx context.Context, conn *m2.Client, input *m2.ListDataSetsInput) ([]awstypes.DataSetSummary, error) {
+	var output []awstypes.DataSetSummary
+
+	pages := m2.NewListDataSetsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.DataSets...)
+	}
+
+	return output, nil
+}
+
+type dataSetsDataSourceModel struct {
+	DataSets      fwtypes.ListNestedObjectValueOf[dataSetSummaryModel] `tfsdk:"data_sets"`
+	EnvironmentID types.String                                         `tfsdk:"environment_id"`
+	ID            types.String                                         `tfsdk:"id"`
+	NamePrefix    types.String                                         `tfsdk:"name_prefix"`
+}
+
+type dataSetSummaryModel struct {
+	DataSetName     types.String      `tfsdk:"dataset_name"`
+	DataSetOrg      types.String      `tfsdk:"dataset_org"`
+	Format          types.String      `tfsdk:"format"`
+	LastUpdatedTime timetypes.RFC3339 `tfsdk:"last_updated"`
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccM2DataSetsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSetName := "TFACC." + strings.ToUpper(sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha))
+	dataSourceName := "data.aws_m2_data_sets.test"
+	resourceName := "aws_m2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetsDataSourceConfig_base(rName, dataSetName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataSetImported(ctx, resourceName, "aws_s3_object.data_set", dataSetName),
+				),
+			},
+			{
+				Config: testAccDataSetsDataSourceConfig_basic(rName, dataSetName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "environment_id", resourceName, "environment_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "data_sets.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "data_sets.0.dataset_name", dataSetName),
+					resource.TestCheckResourceAttr(dataSourceName, "data_sets.0.dataset_org", "VSAM"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDataSetImported imports a VSAM data set from the specified S3 object into the deployed application,
+// waiting for the import to complete. There's no resource for data sets.
+func testAccCheckDataSetImported(ctx context.Context, deploymentResourceName, objectResourceName, dataSetName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		deployment, ok := s.RootModule().Resources[deploymentResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", deploymentResourceName)
+		}
+
+		object, ok := s.RootModule().Resources[objectResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", objectResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
+		applicationID := deployment.Primary.Attributes[names.AttrApplicationID]
+
+		output, err := conn.CreateDataSetImportTask(ctx, &m2.CreateDataSetImportTaskInput{
+			ApplicationId: aws.String(applicationID),
+			ImportConfig: &awstypes.DataSetImportConfigMemberDataSets{
+				Value: []awstypes.DataSetImportItem{{
+					DataSet: &awstypes.DataSet{
+						DatasetName: aws.String(dataSetName),
+						DatasetOrg: &awstypes.DatasetOrgAttributesMemberVsam{
+							Value: awstypes.VsamAttributes{
+								Format:     aws.String("KS"),
+								PrimaryKey: &awstypes.PrimaryKey{Length: 8, Offset: 0},
+							},
+						},
+						RecordLength: &awstypes.RecordLength{Max: 80, Min: 80},
+					},
+					ExternalLocation: &awstypes.ExternalLocationMemberS3Location{
+						Value: fmt.Sprintf("s3://%s/%s", object.Primary.Attributes[names.AttrBucket], object.Primary.Attributes[names.AttrKey]),
+					},
+				}},
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		stateConf := &retry.StateChangeConf{
+			Pending: enum.Slice(awstypes.DataSetTaskLifecycleCreating, awstypes.DataSetTaskLifecycleRunning),
+			Target:  enum.Slice(awstypes.DataSetTaskLifecycleCompleted),
+			Refresh: func() (interface{}, string, error) {
+				output, err := conn.GetDataSetImportTask(ctx, &m2.GetDataSetImportTaskInput{
+					ApplicationId: aws.String(applicationID),
+					TaskId:        output.TaskId,
+				})
+
+				if err != nil {
+					return nil, "", err
+				}
+
+				return output, string(output.Status), nil
+			},
+			Timeout: 30 * time.Minute,
+		}
+
+		_, err = stateConf.WaitForStateContext(ctx)
+
+		return err
+	}
+}
+
+func TestFindDataSetsByEnvironmentIDAndNamePrefix(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{"applications":[{"applicationId":"app-1"},{"applicationId":"app-2"},{"applicationId":"app-3"}]}`},
+		mockResponse{body: `{"dataSets":[{"dataSetName":"PROD.CUSTOMERS","dataSetOrg":"VSAM","format":"FB"}],"nextToken":"token"}`},
+		mockResponse{body: `{"dataSets":[{"dataSetName":"PROD.ORDERS","dataSetOrg":"VSAM","format":"FB"}]}`},
+		mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "application not found"),
+		mockResponse{body: `{"dataSets":[]}`},
+	)
+
+	output, err := tfm2.FindDataSetsByEnvironmentIDAndNamePrefix(context.Background(), conn, "env-1", "PROD.")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(output), 2; got != want {
+		t.Fatalf("data sets = %d, want %d", got, want)
+	}
+
+	if got, want := aws.ToString(output[1].DataSetName), "PROD.ORDERS"; got != want {
+		t.Errorf("DataSetName = %q, want %q", got, want)
+	}
+
+	if got, want := httpClient.requestCount(), 5; got != want {
+		t.Fatalf("requests = %d, want %d", got, want)
+	}
+
+	if got, want := httpClient.requests[1].URL.Query().Get("prefix"), "PROD."; got != want {
+		t.Errorf("prefix = %q, want %q", got, want)
+	}
+
+	if got, want := httpClient.requests[1].URL.Path, "/applications/app-1/datasets"; !strings.EqualFold(got, want) {
+		t.Errorf("path = %q, want %q", got, want)
+	}
+}
+
+func testAccDataSetsDataSourceConfig_base(rName, dataSetName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_basic(rName, "bluage", 1, 1, true), fmt.Sprintf(`
+resource "aws_s3_object" "data_set" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "data-sets/%[1]s.dat"
+  content = format("%%-80s", "00000001")
+}
+`, dataSetName))
+}
+
+func testAccDataSetsDataSourceConfig_basic(rName, dataSetName string) string {
+	return acctest.ConfigCompose(testAccDataSetsDataSourceConfig_base(rName, dataSetName), fmt.Sprintf(`
+data "aws_m2_data_sets" "test" {
+  environment_id = aws_m2_deployment.test.environment_id
+  name_prefix    = %[1]q
+}
+`, dataSetName))
+}
@@ -13,13 +13,19 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/m2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
@@ -63,8 +69,27 @@ func (r *deploymentResource) Schema(ctx context.Context, request resource.Schema
 			"application_version": schema.Int64Attribute{
 				Required: true,
 			},
+			"auto_rollback": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"current_deployed_version": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"deployment_id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"environment_id": schema.StringAttribute{
 				Required: true,
@@ -76,6 +101,19 @@ func (r *deploymentResource) Schema(ctx context.Context, request resource.Schema
 				Optional: true,
 			},
 			names.AttrID: framework.IDAttribute(),
+			"listener_ports": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"load_balancer_dns_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"start": schema.BoolAttribute{
 				Required: true,
 			},
@@ -108,25 +146,27 @@ func (r *deploymentResource) Create(ctx context.Context, request resource.Create
 	// Additional fields.
 	input.ClientToken = aws.String(sdkid.UniqueId())
 
-	output, err := conn.CreateDeployment(ctx, input)
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	deploymentID, deployment, err := createDeployment(ctx, conn, input, waitDeploymentCreated, timeout)
 
-	if err != nil {
+	if err != nil && deploymentID == "" {
 		response.Diagnostics.AddError("creating Mainframe Modernization Deployment", err.Error())
 
 		return
 	}
 
 	// Set values for unknowns.
-	data.DeploymentID = fwflex.StringToFramework(ctx, output.DeploymentId)
+	data.DeploymentID = types.StringValue(deploymentID)
 	data.setID()
 
-	timeout := r.CreateTimeout(ctx, data.Timeouts)
-	if _, err := waitDeploymentCreated(ctx, conn, data.ApplicationID.ValueString(), data.DeploymentID.ValueString(), timeout); err != nil {
+	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Deployment (%s) create", data.ID.ValueString()), err.Error())
 
 		return
 	}
 
+	data.CreationTime = timetypes.NewRFC3339TimePointerValue(deployment.CreationTime)
+
 	if data.Start.ValueBool() {
 		applicationID := data.ApplicationID.ValueString()
 		if _, err := startApplication(ctx, conn, applicationID, timeout); err != nil {
@@ -136,6 +176,11 @@ func (r *deploymentResource) Create(ctx context.Context, request resource.Create
 		}
 	}
 
+	response.Diagnostics.Append(data.refreshApplicationAttributes(ctx, conn)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
@@ -184,6 +229,8 @@ func (r *deploymentResource) Read(ctx context.Context, request resource.ReadRequ
 	}
 
 	data.Start = types.BoolValue(outputGA.Status == awstypes.ApplicationLifecycleRunning)
+	data.setApplicationAttributes(outputGA)
+	data.setDeployedVersionDrift(outputGA)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -203,73 +250,146 @@ func (r *deploymentResource) Update(ctx context.Context, request resource.Update
 
 	timeout := r.UpdateTimeout(ctx, new.Timeouts)
 	if !new.ApplicationVersion.Equal(old.ApplicationVersion) {
-		applicationID := new.ApplicationID.ValueString()
+		redeployed, diags := redeployDeploymentModel(ctx, conn, old, new, timeout)
+		response.Diagnostics.Append(diags...)
 
-		// Stop the application if it was running.
-		if old.Start.ValueBool() {
+		if redeployed != nil {
+			response.Diagnostics.Append(response.State.Set(ctx, redeployed)...)
+		}
+
+		return
+	}
+
+	// No new deployment is created, so the existing deployment is kept.
+	new.CreationTime = old.CreationTime
+	new.DeploymentID = old.DeploymentID
+
+	// Start/stop deployment if no other update is needed
+	if !old.Start.Equal(new.Start) {
+		applicationID := new.ApplicationID.ValueString()
+		if new.Start.ValueBool() {
+			if _, err := startApplication(ctx, conn, applicationID, timeout); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("starting Mainframe Modernization Application (%s)", applicationID), err.Error())
+			}
+		} else {
 			if _, err := stopApplicationIfRunning(ctx, conn, applicationID, new.ForceStop.ValueBool(), timeout); err != nil {
 				response.Diagnostics.AddError(fmt.Sprintf("stopping Mainframe Modernization Application (%s)", applicationID), err.Error())
-
-				return
 			}
 		}
+	}
 
-		input := &m2.CreateDeploymentInput{}
-		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
-		if response.Diagnostics.HasError() {
-			return
-		}
+	response.Diagnostics.Append(response.State.Set(ctx, new)...)
+}
 
-		// Additional fields.
-		input.ClientToken = aws.String(sdkid.UniqueId())
+// redeployDeploymentModel deploys the planned application version, rolling back to the version recorded in state
+// if the deployment fails and auto_rollback is set.
+// It returns the model to save in state, or nil if the state recorded before the update is to be kept.
+func redeployDeploymentModel(ctx context.Context, conn *m2.Client, old, new deploymentResourceModel, timeout time.Duration) (*deploymentResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-		output, err := conn.CreateDeployment(ctx, input)
+	applicationID := new.ApplicationID.ValueString()
 
-		if err != nil {
-			response.Diagnostics.AddError("creating Mainframe Modernization Deployment", err.Error())
+	// Stop the application if it was running.
+	if old.Start.ValueBool() {
+		if _, err := stopApplicationIfRunning(ctx, conn, applicationID, new.ForceStop.ValueBool(), timeout); err != nil {
+			diags.AddError(fmt.Sprintf("stopping Mainframe Modernization Application (%s)", applicationID), err.Error())
 
-			return
+			return nil, diags
 		}
+	}
+
+	input := &m2.CreateDeploymentInput{}
+	diags.Append(fwflex.Expand(ctx, new, input)...)
+	if diags.HasError() {
+		return nil, diags
+	}
 
-		// Set values for unknowns.
-		new.DeploymentID = fwflex.StringToFramework(ctx, output.DeploymentId)
-		new.setID()
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
 
-		if _, err := waitDeploymentUpdated(ctx, conn, new.ApplicationID.ValueString(), new.DeploymentID.ValueString(), timeout); err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Deployment (%s) update", new.ID.ValueString()), err.Error())
+	deploymentID, deployment, err := createDeployment(ctx, conn, input, waitDeploymentUpdated, timeout)
 
-			return
+	if err != nil && deploymentID == "" {
+		diags.AddError("creating Mainframe Modernization Deployment", err.Error())
+
+		return nil, diags
+	}
+
+	// Set values for unknowns.
+	new.DeploymentID = types.StringValue(deploymentID)
+	new.setID()
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("waiting for Mainframe Modernization Deployment (%s) update", new.ID.ValueString()), err.Error())
+
+		if new.AutoRollback.ValueBool() && deployment != nil && deployment.Status == awstypes.DeploymentLifecycleFailed {
+			rolledBack, d := rollbackDeploymentModel(ctx, conn, old, timeout)
+			diags.Append(d...)
+
+			return rolledBack, diags
 		}
 
-		// Start the application if plan says to.
-		if new.Start.ValueBool() {
-			applicationID := new.ApplicationID.ValueString()
-			if _, err := startApplication(ctx, conn, applicationID, timeout); err != nil {
-				response.Diagnostics.AddError(fmt.Sprintf("starting Mainframe Modernization Application (%s)", applicationID), err.Error())
-				return
-			}
+		return nil, diags
+	}
+
+	new.CreationTime = timetypes.NewRFC3339TimePointerValue(deployment.CreationTime)
+
+	// Start the application if plan says to.
+	if new.Start.ValueBool() {
+		if _, err := startApplication(ctx, conn, applicationID, timeout); err != nil {
+			diags.AddError(fmt.Sprintf("starting Mainframe Modernization Application (%s)", applicationID), err.Error())
+
+			return nil, diags
 		}
+	}
 
-		response.Diagnostics.Append(response.State.Set(ctx, new)...)
-		return
+	diags.Append(new.refreshApplicationAttributes(ctx, conn)...)
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	// Start/stop deployment if no other update is needed
-	if !old.Start.Equal(new.Start) {
-		applicationID := new.ApplicationID.ValueString()
-		new.DeploymentID = old.DeploymentID
-		if new.Start.ValueBool() {
-			if _, err := startApplication(ctx, conn, applicationID, timeout); err != nil {
-				response.Diagnostics.AddError(fmt.Sprintf("starting Mainframe Modernization Application (%s)", applicationID), err.Error())
-			}
-		} else {
-			if _, err := stopApplicationIfRunning(ctx, conn, applicationID, new.ForceStop.ValueBool(), timeout); err != nil {
-				response.Diagnostics.AddError(fmt.Sprintf("stopping Mainframe Modernization Application (%s)", applicationID), err.Error())
-			}
+	return &new, diags
+}
+
+// rollbackDeploymentModel redeploys the application version recorded in state after a failed deployment,
+// returning the model that records the rollback deployment.
+func rollbackDeploymentModel(ctx context.Context, conn *m2.Client, old deploymentResourceModel, timeout time.Duration) (*deploymentResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	applicationID := old.ApplicationID.ValueString()
+	applicationVersion, err := expandApplicationVersion(old.ApplicationVersion)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("rolling back Mainframe Modernization Application (%s)", applicationID), err.Error())
+
+		return nil, diags
+	}
+
+	deployment, err := rollbackDeployment(ctx, conn, applicationID, old.EnvironmentID.ValueString(), applicationVersion, timeout)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("rolling back Mainframe Modernization Application (%s) to version %d", applicationID, applicationVersion), err.Error())
+
+		return nil, diags
+	}
+
+	old.CreationTime = timetypes.NewRFC3339TimePointerValue(deployment.CreationTime)
+	old.CurrentDeployedVersion = flattenApplicationVersion(applicationVersion)
+	old.DeploymentID = fwflex.StringToFramework(ctx, deployment.DeploymentId)
+	old.setID()
+
+	if old.Start.ValueBool() {
+		if _, err := startApplication(ctx, conn, applicationID, timeout); err != nil {
+			diags.AddError(fmt.Sprintf("starting Mainframe Modernization Application (%s)", applicationID), err.Error())
 		}
 	}
 
-	response.Diagnostics.Append(response.State.Set(ctx, new)...)
+	diags.AddWarning(
+		"Deployment Rolled Back",
+		fmt.Sprintf("Mainframe Modernization Application (%s) version %d has been redeployed (deployment %s) after the failed deployment.", applicationID, applicationVersion, old.DeploymentID.ValueString()),
+	)
+
+	return &old, diags
 }
 
 func (r *deploymentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -326,8 +446,13 @@ func (r *deploymentResource) ModifyPlan(ctx context.Context, request resource.Mo
 		}
 
 		if !plan.ApplicationVersion.Equal(state.ApplicationVersion) {
-			// If the ApplicationVersion changes, ID becomes unknown.
+			// If the ApplicationVersion changes, a new deployment is created.
+			plan.CreationTime = timetypes.NewRFC3339Unknown()
+			plan.CurrentDeployedVersion = types.Int64Unknown()
+			plan.DeploymentID = types.StringUnknown()
 			plan.ID = types.StringUnknown()
+			plan.ListenerPorts = types.ListUnknown(types.Int64Type)
+			plan.LoadBalancerDNSName = types.StringUnknown()
 		}
 
 		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
@@ -360,6 +485,30 @@ func findDeploymentByTwoPartKey(ctx context.Context, conn *m2.Client, applicatio
 	return output, nil
 }
 
+func findDeployments(ctx context.Context, conn *m2.Client, input *m2.ListDeploymentsInput) ([]awstypes.DeploymentSummary, error) {
+	var output []awstypes.DeploymentSummary
+
+	pages := m2.NewListDeploymentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Deployments...)
+	}
+
+	return output, nil
+}
+
 func statusDeployment(ctx context.Context, conn *m2.Client, applicationID, deploymentID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findDeploymentByTwoPartKey(ctx, conn, applicationID, deploymentID)
@@ -376,6 +525,52 @@ func statusDeployment(ctx context.Context, conn *m2.Client, applicationID, deplo
 	}
 }
 
+func deploymentEnvironmentMutexKey(environmentID string) string {
+	return "m2_deployment_environment_" + environmentID
+}
+
+// deploymentWaiter waits for the specified deployment to succeed.
+type deploymentWaiter func(ctx context.Context, conn *m2.Client, applicationID, deploymentID string, timeout time.Duration) (*m2.GetDeploymentOutput, error)
+
+// createDeployment creates a deployment and waits for it with the specified waiter.
+// Deployments to an environment are serialized by AWS, so only one deployment to an environment is created and waited for at a time.
+// The ID of the created deployment is returned even if waiting for it fails, and is empty, with an error, if the deployment wasn't created.
+func createDeployment(ctx context.Context, conn *m2.Client, input *m2.CreateDeploymentInput, wait deploymentWaiter, timeout time.Duration) (string, *m2.GetDeploymentOutput, error) {
+	mutexKey := deploymentEnvironmentMutexKey(aws.ToString(input.EnvironmentId))
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	output, err := conn.CreateDeployment(ctx, input)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	deploymentID := aws.ToString(output.DeploymentId)
+
+	if deploymentID == "" {
+		return "", nil, errors.New("empty deployment ID")
+	}
+
+	deployment, err := wait(ctx, conn, aws.ToString(input.ApplicationId), deploymentID, timeout)
+
+	return deploymentID, deployment, err
+}
+
+// rollbackDeployment deploys the specified application version, waiting for the deployment to succeed.
+func rollbackDeployment(ctx context.Context, conn *m2.Client, applicationID, environmentID string, applicationVersion int32, timeout time.Duration) (*m2.GetDeploymentOutput, error) {
+	input := &m2.CreateDeploymentInput{
+		ApplicationId:      aws.String(applicationID),
+		ApplicationVersion: aws.Int32(applicationVersion),
+		ClientToken:        aws.String(sdkid.UniqueId()),
+		EnvironmentId:      aws.String(environmentID),
+	}
+
+	_, deployment, err := createDeployment(ctx, conn, input, waitDeploymentUpdated, timeout)
+
+	return deployment, err
+}
+
 func waitDeploymentCreated(ctx context.Context, conn *m2.Client, applicationID, deploymentID string, timeout time.Duration) (*m2.GetDeploymentOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.DeploymentLifecycleDeploying),
@@ -415,14 +610,63 @@ func waitDeploymentUpdated(ctx context.Context, conn *m2.Client, applicationID,
 }
 
 type deploymentResourceModel struct {
-	ApplicationID      types.String   `tfsdk:"application_id"`
-	ApplicationVersion types.Int64    `tfsdk:"application_version"`
-	DeploymentID       types.String   `tfsdk:"deployment_id"`
-	EnvironmentID      types.String   `tfsdk:"environment_id"`
-	ForceStop          types.Bool     `tfsdk:"force_stop"`
-	ID                 types.String   `tfsdk:"id"`
-	Start              types.Bool     `tfsdk:"start"`
-	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	ApplicationID          types.String      `tfsdk:"application_id"`
+	ApplicationVersion     types.Int64       `tfsdk:"application_version"`
+	AutoRollback           types.Bool        `tfsdk:"auto_rollback"`
+	CreationTime           timetypes.RFC3339 `tfsdk:"creation_time"`
+	CurrentDeployedVersion types.Int64       `tfsdk:"current_deployed_version"`
+	DeploymentID           types.String      `tfsdk:"deployment_id"`
+	EnvironmentID          types.String      `tfsdk:"environment_id"`
+	ForceStop              types.Bool        `tfsdk:"force_stop"`
+	ID                     types.String      `tfsdk:"id"`
+	ListenerPorts          types.List        `tfsdk:"listener_ports"`
+	LoadBalancerDNSName    types.String      `tfsdk:"load_balancer_dns_name"`
+	Start                  types.Bool        `tfsdk:"start"`
+	Timeouts               timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// refreshApplicationAttributes sets the attributes read from the deployed application from its current state.
+func (model *deploymentResourceModel) refreshApplicationAttributes(ctx context.Context, conn *m2.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	application, err := findApplicationByID(ctx, conn, model.ApplicationID.ValueString())
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Mainframe Modernization Application (%s)", model.ApplicationID.ValueString()), err.Error())
+
+		return diags
+	}
+
+	model.setApplicationAttributes(application)
+
+	return diags
+}
+
+// setApplicationAttributes sets the application's currently deployed version and runtime endpoint.
+// The load balancer DNS name and listener ports are only known once the application has been deployed.
+func (model *deploymentResourceModel) setApplicationAttributes(application *m2.GetApplicationOutput) {
+	if v := application.DeployedVersion; v != nil {
+		model.CurrentDeployedVersion = flattenApplicationVersion(aws.ToInt32(v.ApplicationVersion))
+	} else {
+		model.CurrentDeployedVersion = types.Int64Null()
+	}
+
+	ports := make([]attr.Value, 0, len(application.ListenerPorts))
+	for _, port := range application.ListenerPorts {
+		ports = append(ports, types.Int64Value(int64(port)))
+	}
+
+	model.ListenerPorts = types.ListValueMust(types.Int64Type, ports)
+	model.LoadBalancerDNSName = types.StringPointerValue(application.LoadBalancerDnsName)
+}
+
+// setDeployedVersionDrift sets the application version to the one currently deployed, if another version has since been deployed,
+// e.g. from the console, so that the configured version is redeployed.
+// Only successful deployments are considered, so a failed deployment doesn't hide the version that is still running.
+func (model *deploymentResourceModel) setDeployedVersionDrift(application *m2.GetApplicationOutput) {
+	if v := application.DeployedVersion; v != nil && v.Status == awstypes.DeploymentLifecycleSucceeded {
+		model.ApplicationVersion = flattenApplicationVersion(aws.ToInt32(v.ApplicationVersion))
+	}
 }
 
 const (
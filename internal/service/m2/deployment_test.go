@@ -6,14 +6,26 @@ package m2_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -43,6 +55,9 @@ func TestAccM2Deployment_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
 					resource.TestCheckResourceAttr(resourceName, "application_version", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationTime),
+					resource.TestCheckResourceAttrSet(resourceName, "load_balancer_dns_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "listener_ports.#"),
 				),
 			},
 			{
@@ -54,6 +69,431 @@ func TestAccM2Deployment_basic(t *testing.T) {
 	})
 }
 
+func TestRedeployDeploymentModel(t *testing.T) {
+	t.Parallel()
+
+	const (
+		applicationRunning  = `{"applicationId":"app","status":"Running"}`
+		applicationStopped  = `{"applicationId":"app","status":"Stopped"}`
+		deploymentCreated   = `{"deploymentId":"dep-2"}`
+		deploymentFailed    = `{"applicationId":"app","deploymentId":"dep-2","status":"Failed","statusReason":"deployment failed"}`
+		deploymentSucceeded = `{"applicationId":"app","applicationVersion":2,"deploymentId":"dep-2","environmentId":"env","status":"Succeeded"}`
+		rollbackCreated     = `{"deploymentId":"dep-3"}`
+		rollbackSucceeded   = `{"applicationId":"app","applicationVersion":1,"deploymentId":"dep-3","environmentId":"env","status":"Succeeded"}`
+	)
+
+	testCases := map[string]struct {
+		start                      bool
+		autoRollback               bool
+		responses                  []mockResponse
+		expectedErrors             int
+		expectedWarnings           int
+		expectedDeploymentID       string
+		expectedApplicationVersion int64
+		expectedRequests           int
+	}{
+		"succeeded": {
+			responses: []mockResponse{
+				{body: deploymentCreated},
+				{body: deploymentSucceeded},
+				{body: `{"applicationId":"app","deployedVersion":{"applicationVersion":2,"status":"Succeeded"},"listenerPorts":[8080],"loadBalancerDnsName":"lb.example.com"}`},
+			},
+			expectedDeploymentID:       "dep-2",
+			expectedApplicationVersion: 2,
+			expectedRequests:           3,
+		},
+		"failed without auto_rollback": {
+			responses: []mockResponse{
+				{body: deploymentCreated},
+				{body: deploymentFailed},
+			},
+			expectedErrors:   1,
+			expectedRequests: 2,
+		},
+		"wait error with auto_rollback": {
+			autoRollback: true,
+			responses: []mockResponse{
+				{body: deploymentCreated},
+				mockErrorResponse(http.StatusBadRequest, "ValidationException", "invalid request"),
+			},
+			expectedErrors:   1,
+			expectedRequests: 2,
+		},
+		"rolled back": {
+			autoRollback: true,
+			responses: []mockResponse{
+				{body: deploymentCreated},
+				{body: deploymentFailed},
+				{body: rollbackCreated},
+				{body: rollbackSucceeded},
+			},
+			expectedErrors:             1,
+			expectedWarnings:           1,
+			expectedDeploymentID:       "dep-3",
+			expectedApplicationVersion: 1,
+			expectedRequests:           4,
+		},
+		"rollback failed": {
+			autoRollback: true,
+			responses: []mockResponse{
+				{body: deploymentCreated},
+				{body: deploymentFailed},
+				{body: rollbackCreated},
+				{body: `{"applicationId":"app","applicationVersion":1,"deploymentId":"dep-3","environmentId":"env","status":"Failed","statusReason":"rollback failed"}`},
+			},
+			expectedErrors:   2,
+			expectedRequests: 4,
+		},
+		"restarted after rollback": {
+			start:        true,
+			autoRollback: true,
+			responses: []mockResponse{
+				{body: applicationStopped},
+				{body: deploymentCreated},
+				{body: deploymentFailed},
+				{body: rollbackCreated},
+				{body: rollbackSucceeded},
+				{body: `{}`},
+				{body: applicationRunning},
+				{body: applicationRunning},
+			},
+			expectedErrors:             1,
+			expectedWarnings:           1,
+			expectedDeploymentID:       "dep-3",
+			expectedApplicationVersion: 1,
+			expectedRequests:           8,
+		},
+		"restart after rollback failed": {
+			start:        true,
+			autoRollback: true,
+			responses: []mockResponse{
+				{body: applicationStopped},
+				{body: deploymentCreated},
+				{body: deploymentFailed},
+				{body: rollbackCreated},
+				{body: rollbackSucceeded},
+				mockErrorResponse(http.StatusBadRequest, "ValidationException", "application can't be started"),
+			},
+			expectedErrors:             2,
+			expectedWarnings:           1,
+			expectedDeploymentID:       "dep-3",
+			expectedApplicationVersion: 1,
+			expectedRequests:           6,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			old := tfm2.DeploymentResourceModel{
+				ApplicationID:      types.StringValue("app"),
+				ApplicationVersion: types.Int64Value(1),
+				AutoRollback:       types.BoolValue(testCase.autoRollback),
+				DeploymentID:       types.StringValue("dep-1"),
+				EnvironmentID:      types.StringValue("env"),
+				Start:              types.BoolValue(testCase.start),
+			}
+			new := old
+			new.ApplicationVersion = types.Int64Value(2)
+
+			got, diags := tfm2.RedeployDeploymentModel(ctx, conn, old, new, 30*time.Minute)
+
+			if got, want := diags.ErrorsCount(), testCase.expectedErrors; got != want {
+				t.Errorf("errors = %d, want %d: %v", got, want, diags)
+			}
+
+			if got, want := diags.WarningsCount(), testCase.expectedWarnings; got != want {
+				t.Errorf("warnings = %d, want %d", got, want)
+			}
+
+			if got, want := httpClient.requestCount(), testCase.expectedRequests; got != want {
+				t.Fatalf("requests = %d, want %d", got, want)
+			}
+
+			// A nil model keeps the state recorded before the update.
+			if testCase.expectedDeploymentID == "" {
+				if got != nil {
+					t.Errorf("model = %v, want nil", got)
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Fatal("model = nil, want state to be saved")
+			}
+
+			if got, want := got.DeploymentID.ValueString(), testCase.expectedDeploymentID; got != want {
+				t.Errorf("DeploymentID = %q, want %q", got, want)
+			}
+
+			if got, want := got.ID.ValueString(), "app,"+testCase.expectedDeploymentID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+
+			if got, want := got.ApplicationVersion.ValueInt64(), testCase.expectedApplicationVersion; got != want {
+				t.Errorf("ApplicationVersion = %d, want %d", got, want)
+			}
+
+			if got, want := got.CurrentDeployedVersion.ValueInt64(), testCase.expectedApplicationVersion; got != want {
+				t.Errorf("CurrentDeployedVersion = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitDeploymentCreated_failed(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{"applicationId":"app","deploymentId":"dep","status":"Deploying"}`},
+		mockResponse{body: `{"applicationId":"app","deploymentId":"dep","status":"Failed","statusReason":"environment has insufficient capacity"}`},
+	)
+
+	output, err := tfm2.WaitDeploymentCreated(context.Background(), conn, "app", "dep", 30*time.Minute)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := err.Error(), "environment has insufficient capacity"; !strings.Contains(got, want) {
+		t.Errorf("error = %q, want to contain %q", got, want)
+	}
+
+	if got, want := output.Status, awstypes.DeploymentLifecycleFailed; got != want {
+		t.Errorf("status = %q, want %q", got, want)
+	}
+
+	if got, want := httpClient.requestCount(), 2; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestCreateDeployment_environmentMutex(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	// The first deployment is still deploying when its first status is read,
+	// so without the lock the second deployment would be created while it's being waited for.
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{"deploymentId":"dep-1"}`},
+		mockResponse{body: `{"deploymentId":"dep-1","status":"Deploying"}`},
+		mockResponse{body: `{"deploymentId":"dep-1","status":"Succeeded"}`},
+		mockResponse{body: `{"deploymentId":"dep-2"}`},
+		mockResponse{body: `{"deploymentId":"dep-2","status":"Succeeded"}`},
+	)
+
+	start := make(chan struct{})
+	errs := make(chan error, 2)
+	var wg sync.WaitGroup
+
+	for _, applicationID := range []string{"app-1", "app-2"} {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			<-start
+
+			_, _, err := tfm2.CreateDeployment(ctx, conn, &m2.CreateDeploymentInput{
+				ApplicationId:      aws.String(applicationID),
+				ApplicationVersion: aws.Int32(1),
+				ClientToken:        aws.String(applicationID),
+				EnvironmentId:      aws.String("env-1"),
+			}, tfm2.WaitDeploymentCreated, time.Minute)
+
+			errs <- err
+		}()
+	}
+
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+
+	// Each deployment must complete before the next one is created.
+	var got []string
+	for _, request := range httpClient.requests {
+		got = append(got, request.Method)
+	}
+
+	if want := []string{http.MethodPost, http.MethodGet, http.MethodGet, http.MethodPost, http.MethodGet}; !slices.Equal(got, want) {
+		t.Errorf("requests = %v, want %v", got, want)
+	}
+}
+
+func TestCreateDeployment_emptyDeploymentID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{}`},
+	)
+
+	deploymentID, deployment, err := tfm2.CreateDeployment(ctx, conn, &m2.CreateDeploymentInput{
+		ApplicationId:      aws.String("app-1"),
+		ApplicationVersion: aws.Int32(1),
+		ClientToken:        aws.String("app-1"),
+		EnvironmentId:      aws.String("env-1"),
+	}, tfm2.WaitDeploymentCreated, time.Minute)
+
+	if err == nil || !strings.Contains(err.Error(), "empty deployment ID") {
+		t.Errorf("error = %v, want empty deployment ID", err)
+	}
+
+	if deploymentID != "" {
+		t.Errorf("deployment ID = %q, want empty", deploymentID)
+	}
+
+	if deployment != nil {
+		t.Errorf("deployment = %v, want nil", deployment)
+	}
+
+	// The deployment isn't waited for.
+	if got, want := httpClient.requestCount(), 1; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func TestDeploymentResourceModelInitFromID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id                 string
+		expectError        bool
+		expectAppID        string
+		expectDeploymentID string
+	}{
+		"valid": {
+			id:                 "app,dep",
+			expectAppID:        "app",
+			expectDeploymentID: "dep",
+		},
+		"one part": {
+			id:          "app",
+			expectError: true,
+		},
+		"three parts": {
+			id:          "app,dep,extra",
+			expectError: true,
+		},
+		"empty part": {
+			id:          "app,",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := tfm2.DeploymentResourceModel{ID: types.StringValue(testCase.id)}
+			err := data.InitFromID()
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if got, want := err.Error(), testCase.id; !strings.Contains(got, want) {
+					t.Errorf("error = %q, want to contain %q", got, want)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := data.ApplicationID.ValueString(), testCase.expectAppID; got != want {
+				t.Errorf("ApplicationID = %q, want %q", got, want)
+			}
+
+			if got, want := data.DeploymentID.ValueString(), testCase.expectDeploymentID; got != want {
+				t.Errorf("DeploymentID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDeploymentResourceModelFlattenCreationTime(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var data tfm2.DeploymentResourceModel
+	if diags := fwflex.Flatten(ctx, &m2.GetDeploymentOutput{
+		CreationTime: aws.Time(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+		DeploymentId: aws.String("dep-1"),
+	}, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := data.CreationTime.ValueString(), "2024-01-02T03:04:05Z"; got != want {
+		t.Errorf("CreationTime = %q, want %q", got, want)
+	}
+}
+
+func TestDeploymentResourceModelSetDeployedVersionDrift(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		deployedVersion *awstypes.DeployedVersionSummary
+		expected        int64
+	}{
+		"deployed externally": {
+			deployedVersion: &awstypes.DeployedVersionSummary{ApplicationVersion: aws.Int32(2), Status: awstypes.DeploymentLifecycleSucceeded},
+			expected:        2,
+		},
+		"not drifted": {
+			deployedVersion: &awstypes.DeployedVersionSummary{ApplicationVersion: aws.Int32(1), Status: awstypes.DeploymentLifecycleSucceeded},
+			expected:        1,
+		},
+		"external deployment failed": {
+			deployedVersion: &awstypes.DeployedVersionSummary{ApplicationVersion: aws.Int32(2), Status: awstypes.DeploymentLifecycleFailed},
+			expected:        1,
+		},
+		"external deployment in progress": {
+			deployedVersion: &awstypes.DeployedVersionSummary{ApplicationVersion: aws.Int32(2), Status: awstypes.DeploymentLifecycleDeploying},
+			expected:        1,
+		},
+		"not deployed": {
+			expected: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := tfm2.DeploymentResourceModel{
+				ApplicationVersion: types.Int64Value(1),
+			}
+
+			tfm2.DeploymentResourceModelSetDeployedVersionDrift(&data, &m2.GetApplicationOutput{
+				DeployedVersion: testCase.deployedVersion,
+			})
+
+			if got, want := data.ApplicationVersion.ValueInt64(), testCase.expected; got != want {
+				t.Errorf("ApplicationVersion = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
 func TestAccM2Deployment_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -127,6 +567,84 @@ func TestAccM2Deployment_start(t *testing.T) {
 	})
 }
 
+func TestAccM2Deployment_createAndStart(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var application m2.GetApplicationOutput
+	var deployment m2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The environment, application and deployment are all created, and the application started, in a single apply.
+				Config: testAccDeploymentConfig_basic(rName, "bluage", 1, 1, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
+					testAccCheckApplicationExists(ctx, "aws_m2_application.test", &application),
+					testAccCheckApplicationStatus(&application, awstypes.ApplicationLifecycleRunning),
+				),
+			},
+		},
+	})
+}
+
+func TestAccM2Deployment_deployedVersionDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var deployment m2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, "bluage", 2, 1, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
+					resource.TestCheckResourceAttr(resourceName, "current_deployed_version", acctest.Ct1),
+					testAccCheckDeploymentDeployVersion(ctx, &deployment, 2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDeploymentConfig_basic(rName, "bluage", 2, 1, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
+					resource.TestCheckResourceAttr(resourceName, "application_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "current_deployed_version", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccM2Deployment_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -169,6 +687,94 @@ func TestAccM2Deployment_update(t *testing.T) {
 	})
 }
 
+func TestAccM2Deployment_autoRollback(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var before, after m2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_autoRollback(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "auto_rollback", acctest.CtFalse),
+				),
+			},
+			{
+				// Only auto_rollback changes, so the application isn't redeployed and the computed attributes are kept.
+				Config: testAccDeploymentConfig_autoRollback(rName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrCreationTime), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("current_deployed_version"), knownvalue.Int64Exact(1)),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("deployment_id"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("listener_ports"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("load_balancer_dns_name"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "application_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "auto_rollback", acctest.CtTrue),
+					func(*terraform.State) error {
+						if got, want := aws.ToString(after.DeploymentId), aws.ToString(before.DeploymentId); got != want {
+							return fmt.Errorf("deployment_id = %s, want %s", got, want)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDeploymentDeployVersion deploys the specified application version outside of Terraform.
+func testAccCheckDeploymentDeployVersion(ctx context.Context, v *m2.GetDeploymentOutput, version int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
+
+		output, err := conn.CreateDeployment(ctx, &m2.CreateDeploymentInput{
+			ApplicationId:      v.ApplicationId,
+			ApplicationVersion: aws.Int32(version),
+			ClientToken:        aws.String(sdkacctest.RandString(32)),
+			EnvironmentId:      v.EnvironmentId,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfm2.WaitDeploymentUpdated(ctx, conn, aws.ToString(v.ApplicationId), aws.ToString(output.DeploymentId), 60*time.Minute)
+
+		return err
+	}
+}
+
+func testAccCheckApplicationStatus(v *m2.GetApplicationOutput, want awstypes.ApplicationLifecycle) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := v.Status; got != want {
+			return fmt.Errorf("Mainframe Modernization Application (%s) status = %s, want %s", aws.ToString(v.ApplicationId), got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
@@ -255,3 +861,44 @@ resource "aws_m2_deployment" "test" {
 }
 `, rName, engineType, deployVersion, start))
 }
+
+func testAccDeploymentConfig_autoRollback(rName string, autoRollback bool) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), testAccApplicationConfig_versioned(rName, "bluage", 1, 2), fmt.Sprintf(`
+resource "aws_m2_environment" "test" {
+  name          = %[1]q
+  engine_type   = "bluage"
+  instance_type = "M2.m5.large"
+
+  security_group_ids = [aws_security_group.test.id]
+  subnet_ids         = aws_subnet.test[*].id
+}
+
+data "aws_region" "current" {}
+
+resource "aws_vpc_endpoint" "secretsmanager" {
+  vpc_id            = aws_vpc.test.id
+  service_name      = "com.amazonaws.${data.aws_region.current.name}.secretsmanager"
+  vpc_endpoint_type = "Interface"
+
+  security_group_ids = [
+    aws_security_group.test.id,
+  ]
+  subnet_ids = aws_subnet.test[*].id
+
+  private_dns_enabled = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_m2_deployment" "test" {
+  environment_id      = aws_m2_environment.test.id
+  application_id      = aws_m2_application.test.id
+  application_version = 1
+  start               = true
+  auto_rollback       = %[2]t
+  depends_on          = [aws_vpc_endpoint.secretsmanager]
+}
+`, rName, autoRollback))
+}
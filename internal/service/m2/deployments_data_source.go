@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Deployments")
+func newDeploymentsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &deploymentsDataSource{}, nil
+}
+
+type deploymentsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*deploymentsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_m2_deployments"
+}
+
+func (d *deploymentsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"deployments": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[deploymentSummaryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						names.AttrApplicationID: types.StringType,
+						"application_version":   types.Int64Type,
+						"deployment_id":         types.StringType,
+						names.AttrStatus:        fwtypes.StringEnumType[awstypes.DeploymentLifecycle](),
+					},
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *deploymentsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data deploymentsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().M2Client(ctx)
+
+	environmentID := data.EnvironmentID.ValueString()
+	output, err := findDeploymentsByEnvironmentID(ctx, conn, environmentID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Environment (%s) deployments", environmentID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Deployments)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.EnvironmentID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findDeploymentsByEnvironmentID returns all deployments to the specified environment.
+// Deployments can only be listed per application, so the environment's applications are listed first.
+func findDeploymentsByEnvironmentID(ctx context.Context, conn *m2.Client, environmentID string) ([]awstypes.DeploymentSummary, error) {
+	applications, err := findApplications(ctx, conn, &m2.ListApplicationsInput{
+		EnvironmentId: aws.String(environmentID),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []awstypes.DeploymentSummary
+
+	for _, application := range applications {
+		deployments, err := findDeployments(ctx, conn, &m2.ListDeploymentsInput{
+			ApplicationId: application.ApplicationId,
+		})
+
+		// The application may have been deleted since being listed.
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, deployment := range deployments {
+			if aws.ToString(deployment.EnvironmentId) == environmentID {
+				output = append(output, deployment)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type deploymentsDataSourceModel struct {
+	Deployments   fwtypes.ListNestedObjectValueOf[deploymentSummaryModel] `tfsdk:"deployments"`
+	EnvironmentID types.String                                            `tfsdk:"environment_id"`
+	ID            types.String                                            `tfsdk:"id"`
+}
+
+type deploymentSummaryModel struct {
+	ApplicationID      types.String                                     `tfsdk:"application_id"`
+	ApplicationVersion types.Int64                                      `tfsdk:"application_version"`
+	DeploymentID       types.String                                     `tfsdk:"deployment_id"`
+	Status             fwtypes.StringEnum[awstypes.DeploymentLifecycle] `tfsdk:"status"`
+}
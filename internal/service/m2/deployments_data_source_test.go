@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccM2DeploymentsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_m2_deployments.test"
+	resourceName := "aws_m2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "environment_id", resourceName, "environment_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployments.0.application_id", resourceName, names.AttrApplicationID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployments.0.application_version", resourceName, "application_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployments.0.deployment_id", resourceName, "deployment_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployments.0.status", "Succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func TestFindDeploymentsByEnvironmentID(t *testing.T) {
+	t.Parallel()
+
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{"applications":[{"applicationId":"app-1"},{"applicationId":"app-2"}]}`},
+		mockResponse{body: `{"deployments":[{"applicationId":"app-1","deploymentId":"dep-1","environmentId":"env-1"},{"applicationId":"app-1","deploymentId":"dep-2","environmentId":"env-2"}]}`},
+		mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "application not found"),
+	)
+
+	output, err := tfm2.FindDeploymentsByEnvironmentID(context.Background(), conn, "env-1")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(output), 1; got != want {
+		t.Fatalf("deployments = %d, want %d", got, want)
+	}
+
+	if got, want := aws.ToString(output[0].DeploymentId), "dep-1"; got != want {
+		t.Errorf("DeploymentId = %q, want %q", got, want)
+	}
+
+	if got, want := httpClient.requestCount(), 3; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+}
+
+func testAccDeploymentsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_basic(rName, "bluage", 1, 1, true), `
+data "aws_m2_deployments" "test" {
+  environment_id = aws_m2_deployment.test.environment_id
+}
+`)
+}
@@ -7,13 +7,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/m2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -23,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
@@ -37,6 +46,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfelbv2 "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -48,7 +61,7 @@ func newEnvironmentResource(context.Context) (resource.ResourceWithConfigure, er
 	r := &environmentResource{}
 
 	r.SetDefaultCreateTimeout(30 * time.Minute)
-	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	// The default Update timeout depends on whether the engine version is changing, see environmentUpdateTimeout.
 	r.SetDefaultDeleteTimeout(30 * time.Minute)
 
 	return r, nil
@@ -56,7 +69,6 @@ func newEnvironmentResource(context.Context) (resource.ResourceWithConfigure, er
 
 type environmentResource struct {
 	framework.ResourceWithConfigure
-	framework.WithImportByID
 	framework.WithTimeouts
 }
 
@@ -70,7 +82,20 @@ func (r *environmentResource) Schema(ctx context.Context, request resource.Schem
 			"apply_changes_during_maintenance_window": schema.BoolAttribute{
 				Optional: true,
 			},
+			"actual_capacity": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrDescription: schema.StringAttribute{
 				Optional: true,
 				Validators: []validator.String{
@@ -121,10 +146,16 @@ func (r *environmentResource) Schema(ctx context.Context, request resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"load_balancer_dns_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrName: schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
-					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]{1,59}$`), ""),
+					stringvalidator.RegexMatches(environmentNameRegex, ""),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -134,6 +165,9 @@ func (r *environmentResource) Schema(ctx context.Context, request resource.Schem
 				CustomType: fwtypes.OnceAWeekWindowType,
 				Optional:   true,
 				Computed:   true,
+				Validators: []validator.String{
+					maintenanceWindowMinDurationValidator(30 * time.Minute),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -174,6 +208,12 @@ func (r *environmentResource) Schema(ctx context.Context, request resource.Schem
 			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVPCID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"high_availability_config": schema.ListNestedBlock{
@@ -301,14 +341,35 @@ func (r *environmentResource) Create(ctx context.Context, request resource.Creat
 		input.StorageConfigurations = storageConfigurations
 	}
 
+	// Subnets in different VPCs otherwise only fail once the environment is provisioning.
+	if len(input.SubnetIds) > 1 {
+		subnets, err := tfec2.FindSubnets(ctx, r.Meta().EC2Conn(ctx), &ec2.DescribeSubnetsInput{
+			SubnetIds: aws_sdkv1.StringSlice(input.SubnetIds),
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Environment (%s) subnets", name), err.Error())
+
+			return
+		}
+
+		if err := validateSubnetsInSameVPC(subnets); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrSubnetIDs), fmt.Sprintf("creating Mainframe Modernization Environment (%s)", name), err.Error())
+
+			return
+		}
+	}
+
 	// Additional fields.
 	input.ClientToken = aws.String(sdkid.UniqueId())
 	input.Tags = getTagsIn(ctx)
 
-	output, err := conn.CreateEnvironment(ctx, input)
+	output, err := retryWhenLimitExceeded(ctx, limitExceededTimeout, func() (*m2.CreateEnvironmentOutput, error) {
+		return conn.CreateEnvironment(ctx, input)
+	})
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("creating Mainframe Modernization Environment (%s)", name), err.Error())
+		response.Diagnostics.Append(environmentErrorDiagnostic(fmt.Sprintf("creating Mainframe Modernization Environment (%s)", name), err))
 
 		return
 	}
@@ -331,6 +392,14 @@ func (r *environmentResource) Create(ctx context.Context, request resource.Creat
 		return
 	}
 
+	data.LoadBalancerDNSName, err = findLoadBalancerDNSNameByARN(ctx, r.Meta().ELBV2Conn(ctx), aws.ToString(env.LoadBalancerArn))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Environment (%s) load balancer", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
@@ -381,6 +450,14 @@ func (r *environmentResource) Read(ctx context.Context, request resource.ReadReq
 		data.StorageConfigurations = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, storageConfigurationsData)
 	}
 
+	data.LoadBalancerDNSName, err = findLoadBalancerDNSNameByARN(ctx, r.Meta().ELBV2Conn(ctx), aws.ToString(output.LoadBalancerArn))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Environment (%s) load balancer", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -397,8 +474,28 @@ func (r *environmentResource) Update(ctx context.Context, request resource.Updat
 
 	conn := r.Meta().M2Client(ctx)
 
-	if !new.EngineVersion.Equal(old.EngineVersion) ||
-		!new.HighAvailabilityConfig.Equal(old.HighAvailabilityConfig) ||
+	// An invalid engine version upgrade is rejected before any other change is made,
+	// so that the environment isn't left partially updated.
+	engineUpgrade := !new.EngineVersion.Equal(old.EngineVersion)
+	if engineUpgrade {
+		engineVersions, err := findEngineVersionsByEngineType(ctx, conn, new.EngineType.ValueEnum())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization %s engine versions", new.EngineType.ValueString()), err.Error())
+
+			return
+		}
+
+		if err := validateEngineVersionUpgrade(old.EngineVersion.ValueString(), new.EngineVersion.ValueString(), engineVersions); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrEngineVersion), fmt.Sprintf("updating Mainframe Modernization Environment (%s) engine version", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	var env *m2.GetEnvironmentOutput
+
+	if !new.HighAvailabilityConfig.Equal(old.HighAvailabilityConfig) ||
 		!new.InstanceType.Equal(old.InstanceType) ||
 		!new.PreferredMaintenanceWindow.Equal(old.PreferredMaintenanceWindow) {
 		input := &m2.UpdateEnvironmentInput{
@@ -408,32 +505,22 @@ func (r *environmentResource) Update(ctx context.Context, request resource.Updat
 		if !new.ForceUpdate.IsNull() {
 			input.ForceUpdate = new.ForceUpdate.ValueBool()
 		}
-
-		// https://docs.aws.amazon.com/m2/latest/APIReference/API_UpdateEnvironment.html#m2-UpdateEnvironment-request-applyDuringMaintenanceWindow.
-		// "Currently, AWS Mainframe Modernization accepts the engineVersion parameter only if applyDuringMaintenanceWindow is true. If any parameter other than engineVersion is provided in UpdateEnvironmentRequest, it will fail if applyDuringMaintenanceWindow is set to true."
-		if new.ApplyDuringMaintenanceWindow.ValueBool() && !new.EngineVersion.Equal(old.EngineVersion) {
-			input.ApplyDuringMaintenanceWindow = true
-			input.EngineVersion = fwflex.StringFromFramework(ctx, new.EngineVersion)
-		} else {
-			if !new.EngineVersion.Equal(old.EngineVersion) {
-				input.EngineVersion = fwflex.StringFromFramework(ctx, new.EngineVersion)
-			}
-			if !new.HighAvailabilityConfig.Equal(old.HighAvailabilityConfig) {
-				highAvailabilityConfigData, diags := new.HighAvailabilityConfig.ToPtr(ctx)
-				response.Diagnostics.Append(diags...)
-				if response.Diagnostics.HasError() {
-					return
-				}
-
-				input.DesiredCapacity = fwflex.Int32FromFramework(ctx, highAvailabilityConfigData.DesiredCapacity)
-			}
-			if !new.InstanceType.Equal(old.InstanceType) {
-				input.InstanceType = fwflex.StringFromFramework(ctx, new.InstanceType)
-			}
-			if !new.PreferredMaintenanceWindow.Equal(old.PreferredMaintenanceWindow) {
-				input.PreferredMaintenanceWindow = fwflex.StringFromFramework(ctx, new.PreferredMaintenanceWindow)
+		if !new.HighAvailabilityConfig.Equal(old.HighAvailabilityConfig) {
+			highAvailabilityConfigData, diags := new.HighAvailabilityConfig.ToPtr(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
 			}
+
+			input.DesiredCapacity = fwflex.Int32FromFramework(ctx, highAvailabilityConfigData.DesiredCapacity)
+		}
+		if !new.InstanceType.Equal(old.InstanceType) {
+			input.InstanceType = fwflex.StringFromFramework(ctx, new.InstanceType)
 		}
+		if !new.PreferredMaintenanceWindow.Equal(old.PreferredMaintenanceWindow) {
+			input.PreferredMaintenanceWindow = fwflex.StringFromFramework(ctx, new.PreferredMaintenanceWindow)
+		}
+
 		_, err := conn.UpdateEnvironment(ctx, input)
 
 		if err != nil {
@@ -442,13 +529,32 @@ func (r *environmentResource) Update(ctx context.Context, request resource.Updat
 			return
 		}
 
-		if _, err := waitEnvironmentUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		env, err = waitEnvironmentUpdated(ctx, conn, new.ID.ValueString(), environmentUpdateTimeout(ctx, new.Timeouts, false))
+
+		if err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Environment (%s) update", new.ID.ValueString()), err.Error())
 
 			return
 		}
 	}
 
+	if engineUpgrade {
+		applyDuringMaintenanceWindow := new.ApplyDuringMaintenanceWindow.ValueBool()
+		var err error
+		env, err = updateEnvironmentEngineVersion(ctx, conn, new.ID.ValueString(), new.EngineVersion.ValueString(), applyDuringMaintenanceWindow, new.ForceUpdate.ValueBool(), environmentUpdateTimeout(ctx, new.Timeouts, !applyDuringMaintenanceWindow))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Mainframe Modernization Environment (%s) engine version", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	// The actual capacity is only planned to change with the high availability configuration.
+	if new.ActualCapacity.IsUnknown() && env != nil {
+		new.ActualCapacity = fwflex.Int32ToFramework(ctx, env.ActualCapacity)
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
@@ -484,6 +590,285 @@ func (r *environmentResource) Delete(ctx context.Context, request resource.Delet
 
 func (r *environmentResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
+
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state fwtypes.ListNestedObjectValueOf[highAvailabilityConfigModel]
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("high_availability_config"), &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("high_availability_config"), &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing the desired capacity changes the actual capacity.
+	if !plan.Equal(state) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("actual_capacity"), types.Int64Unknown())...)
+	}
+}
+
+func (r *environmentResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	id, name, err := parseEnvironmentImportID(request.ID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("importing Mainframe Modernization Environment (%s)", request.ID), err.Error())
+
+		return
+	}
+
+	// Environments can also be imported by name, e.g. "name=my-environment".
+	if name != "" {
+		conn := r.Meta().M2Client(ctx)
+
+		environment, err := findEnvironmentByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			response.Diagnostics.AddError(fmt.Sprintf("importing Mainframe Modernization Environment (%s)", request.ID), fmt.Sprintf("no environment named %q found", name))
+
+			return
+		}
+
+		if errors.As(err, new(*tfresource.TooManyResultsError)) {
+			response.Diagnostics.AddError(fmt.Sprintf("importing Mainframe Modernization Environment (%s)", request.ID), fmt.Sprintf("more than one environment named %q found, import by environment ID instead", name))
+
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("importing Mainframe Modernization Environment (%s)", request.ID), err.Error())
+
+			return
+		}
+
+		id = aws.ToString(environment.EnvironmentId)
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), id)...)
+}
+
+// parseEnvironmentImportID parses an environment import ID of the form "environment-id" or "name=environment-name",
+// returning either the environment ID or the environment name.
+func parseEnvironmentImportID(id string) (string, string, error) {
+	name, found := strings.CutPrefix(id, environmentImportNamePrefix)
+
+	if !found {
+		return id, "", nil
+	}
+
+	if !environmentNameRegex.MatchString(name) {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ENVIRONMENT-ID or %[2]sENVIRONMENT-NAME", id, environmentImportNamePrefix)
+	}
+
+	return "", name, nil
+}
+
+const (
+	environmentImportNamePrefix = "name="
+)
+
+var (
+	environmentNameRegex = regexache.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]{1,59}$`)
+)
+
+// maintenanceWindowMinDurationValidator validates that a "ddd:hh24:mi-ddd:hh24:mi" maintenance window lasts at least the specified duration.
+// The window's format is validated by its custom type.
+func maintenanceWindowMinDurationValidator(minDuration time.Duration) validator.String {
+	return maintenanceWindowDurationValidator{
+		minDuration: minDuration,
+	}
+}
+
+type maintenanceWindowDurationValidator struct {
+	minDuration time.Duration
+}
+
+func (v maintenanceWindowDurationValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("maintenance window must be at least %s", v.minDuration)
+}
+
+func (v maintenanceWindowDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v maintenanceWindowDurationValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	d, ok := maintenanceWindowDuration(value)
+	if !ok {
+		return
+	}
+
+	if d < v.minDuration {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Maintenance Window",
+			fmt.Sprintf("%s, got %q (%s)", v.Description(ctx), value, d),
+		)
+	}
+}
+
+var (
+	maintenanceWindowDaysOfWeek = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+)
+
+// maintenanceWindowDuration returns the duration of a "ddd:hh24:mi-ddd:hh24:mi" maintenance window.
+// Windows may wrap around the end of the week.
+func maintenanceWindowDuration(window string) (time.Duration, bool) {
+	startTime, endTime, ok := strings.Cut(strings.ToLower(window), "-")
+	if !ok {
+		return 0, false
+	}
+
+	start, ok := maintenanceWindowOffset(startTime)
+	if !ok {
+		return 0, false
+	}
+
+	end, ok := maintenanceWindowOffset(endTime)
+	if !ok {
+		return 0, false
+	}
+
+	const week = 7 * 24 * time.Hour
+
+	return (end - start + week) % week, true
+}
+
+// maintenanceWindowOffset returns the offset of a "ddd:hh24:mi" time from the start of the week.
+func maintenanceWindowOffset(v string) (time.Duration, bool) {
+	parts := strings.Split(v, ":")
+	if len(parts) != 3 {
+		return 0, false
+	}
+
+	day := slices.Index(maintenanceWindowDaysOfWeek, parts[0])
+	if day == -1 {
+		return 0, false
+	}
+
+	hour, err := strconv.Atoi(parts[1])
+	if err != nil || hour < 0 || hour > 23 {
+		return 0, false
+	}
+
+	minute, err := strconv.Atoi(parts[2])
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, false
+	}
+
+	return time.Duration(day)*24*time.Hour + time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, true
+}
+
+// validateSubnetsInSameVPC returns an error listing each VPC's subnets if the subnets don't all belong to the same VPC.
+func validateSubnetsInSameVPC(subnets []*ec2.Subnet) error {
+	subnetIDsByVPCID := make(map[string][]string)
+	for _, subnet := range subnets {
+		vpcID := aws_sdkv1.StringValue(subnet.VpcId)
+		subnetIDsByVPCID[vpcID] = append(subnetIDsByVPCID[vpcID], aws_sdkv1.StringValue(subnet.SubnetId))
+	}
+
+	if len(subnetIDsByVPCID) <= 1 {
+		return nil
+	}
+
+	vpcIDs := tfmaps.Keys(subnetIDsByVPCID)
+	slices.Sort(vpcIDs)
+
+	var vpcs []string
+	for _, vpcID := range vpcIDs {
+		subnetIDs := subnetIDsByVPCID[vpcID]
+		slices.Sort(subnetIDs)
+		vpcs = append(vpcs, fmt.Sprintf("%s: %s", vpcID, strings.Join(subnetIDs, ", ")))
+	}
+
+	return fmt.Errorf("subnet_ids must all belong to the same VPC, found subnets in %d VPCs (%s)", len(vpcIDs), strings.Join(vpcs, "; "))
+}
+
+// findLoadBalancerDNSNameByARN returns the DNS name of the environment's load balancer, which applications in the VPC can use to reach the environment.
+// The Mainframe Modernization API only returns the load balancer's ARN.
+func findLoadBalancerDNSNameByARN(ctx context.Context, conn *elbv2.ELBV2, arn string) (types.String, error) {
+	if arn == "" {
+		return types.StringNull(), nil
+	}
+
+	loadBalancer, err := tfelbv2.FindLoadBalancerByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		return types.StringNull(), nil
+	}
+
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	return types.StringValue(aws_sdkv1.StringValue(loadBalancer.DNSName)), nil
+}
+
+func findEnvironmentByName(ctx context.Context, conn *m2.Client, name string) (*awstypes.EnvironmentSummary, error) {
+	input := &m2.ListEnvironmentsInput{
+		Names: []string{name},
+	}
+
+	return findEnvironment(ctx, conn, input)
+}
+
+func findEnvironment(ctx context.Context, conn *m2.Client, input *m2.ListEnvironmentsInput) (*awstypes.EnvironmentSummary, error) {
+	output, err := findEnvironments(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findEnvironments(ctx context.Context, conn *m2.Client, input *m2.ListEnvironmentsInput) ([]awstypes.EnvironmentSummary, error) {
+	var output []awstypes.EnvironmentSummary
+
+	pages := m2.NewListEnvironmentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Environments...)
+	}
+
+	return output, nil
+}
+
+func findEngineVersionsByEngineType(ctx context.Context, conn *m2.Client, engineType awstypes.EngineType) ([]awstypes.EngineVersionsSummary, error) {
+	input := &m2.ListEngineVersionsInput{
+		EngineType: engineType,
+	}
+
+	return findEngineVersions(ctx, conn, input)
+}
+
+func findEngineVersions(ctx context.Context, conn *m2.Client, input *m2.ListEngineVersionsInput) ([]awstypes.EngineVersionsSummary, error) {
+	var output []awstypes.EngineVersionsSummary
+
+	pages := m2.NewListEngineVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.EngineVersions...)
+	}
+
+	return output, nil
 }
 
 func findEnvironmentByID(ctx context.Context, conn *m2.Client, id string) (*m2.GetEnvironmentOutput, error) {
@@ -504,7 +889,7 @@ func findEnvironmentByID(ctx context.Context, conn *m2.Client, id string) (*m2.G
 		return nil, err
 	}
 
-	if output == nil {
+	if output == nil || output.EnvironmentId == nil {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
@@ -527,11 +912,81 @@ func statusEnvironment(ctx context.Context, conn *m2.Client, id string) retry.St
 	}
 }
 
+// updateEnvironmentEngineVersion upgrades the specified environment's engine version on its own.
+// https://docs.aws.amazon.com/m2/latest/APIReference/API_UpdateEnvironment.html#m2-UpdateEnvironment-request-applyDuringMaintenanceWindow.
+// "Currently, AWS Mainframe Modernization accepts the engineVersion parameter only if applyDuringMaintenanceWindow is true. If any parameter other than engineVersion is provided in UpdateEnvironmentRequest, it will fail if applyDuringMaintenanceWindow is set to true."
+func updateEnvironmentEngineVersion(ctx context.Context, conn *m2.Client, id, engineVersion string, applyDuringMaintenanceWindow, forceUpdate bool, timeout time.Duration) (*m2.GetEnvironmentOutput, error) {
+	input := &m2.UpdateEnvironmentInput{
+		ApplyDuringMaintenanceWindow: applyDuringMaintenanceWindow,
+		EngineVersion:                aws.String(engineVersion),
+		EnvironmentId:                aws.String(id),
+		ForceUpdate:                  forceUpdate,
+	}
+
+	if _, err := conn.UpdateEnvironment(ctx, input); err != nil {
+		return nil, err
+	}
+
+	output, err := waitEnvironmentUpdated(ctx, conn, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return output, nil
+}
+
+// validateEngineVersionUpgrade returns an error if the target engine version isn't a valid upgrade from the current one.
+// The target must be one of the engine type's available versions and, if both versions can be compared, newer than the current one.
+func validateEngineVersionUpgrade(current, target string, engineVersions []awstypes.EngineVersionsSummary) error {
+	available := tfslices.ApplyToAll(engineVersions, func(v awstypes.EngineVersionsSummary) string {
+		return aws.ToString(v.EngineVersion)
+	})
+
+	if !slices.Contains(available, target) {
+		return fmt.Errorf("engine version %s is not available; available versions are: %s", target, strings.Join(available, ", "))
+	}
+
+	currentVersion, err := gversion.NewVersion(current)
+	if err != nil {
+		return nil
+	}
+
+	targetVersion, err := gversion.NewVersion(target)
+	if err != nil {
+		return nil
+	}
+
+	if !targetVersion.GreaterThan(currentVersion) {
+		return fmt.Errorf("engine version %s is not an upgrade from %s; an environment's engine version can't be downgraded, replace the environment instead", target, current)
+	}
+
+	return nil
+}
+
+// environmentUpdateTimeout returns any configured Update timeout value or the update's default value.
+// Engine version upgrades replace the environment's instances and take considerably longer than configuration changes.
+func environmentUpdateTimeout(ctx context.Context, timeouts timeouts.Value, engineUpgrade bool) time.Duration {
+	defaultTimeout := 30 * time.Minute
+	if engineUpgrade {
+		defaultTimeout = 120 * time.Minute
+	}
+
+	timeout, diags := timeouts.Update(ctx, defaultTimeout)
+
+	if diags.HasError() {
+		return defaultTimeout
+	}
+
+	return timeout
+}
+
 func waitEnvironmentCreated(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetEnvironmentOutput, error) {
+	stats := &waiterStats{}
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.EnvironmentLifecycleCreating),
 		Target:  enum.Slice(awstypes.EnvironmentLifecycleAvailable),
-		Refresh: statusEnvironment(ctx, conn, id),
+		Refresh: stats.refresh(statusEnvironment(ctx, conn, id)),
 		Timeout: timeout,
 	}
 
@@ -539,18 +994,22 @@ func waitEnvironmentCreated(ctx context.Context, conn *m2.Client, id string, tim
 
 	if output, ok := outputRaw.(*m2.GetEnvironmentOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+		stats.log(ctx, "environment create", id, string(output.Status), err)
 
 		return output, err
 	}
 
+	stats.log(ctx, "environment create", id, "", err)
+
 	return nil, err
 }
 
 func waitEnvironmentUpdated(ctx context.Context, conn *m2.Client, id string, timeout time.Duration) (*m2.GetEnvironmentOutput, error) {
+	stats := &waiterStats{}
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.EnvironmentLifecycleUpdating),
 		Target:  enum.Slice(awstypes.EnvironmentLifecycleAvailable),
-		Refresh: statusEnvironment(ctx, conn, id),
+		Refresh: stats.refresh(statusEnvironment(ctx, conn, id)),
 		Timeout: timeout,
 	}
 
@@ -558,10 +1017,13 @@ func waitEnvironmentUpdated(ctx context.Context, conn *m2.Client, id string, tim
 
 	if output, ok := outputRaw.(*m2.GetEnvironmentOutput); ok {
 		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+		stats.log(ctx, "environment update", id, string(output.Status), err)
 
 		return output, err
 	}
 
+	stats.log(ctx, "environment update", id, "", err)
+
 	return nil, err
 }
 
@@ -585,7 +1047,9 @@ func waitEnvironmentDeleted(ctx context.Context, conn *m2.Client, id string, tim
 }
 
 type environmentResourceModel struct {
+	ActualCapacity               types.Int64                                                  `tfsdk:"actual_capacity"`
 	ApplyDuringMaintenanceWindow types.Bool                                                   `tfsdk:"apply_changes_during_maintenance_window"`
+	CreationTime                 timetypes.RFC3339                                            `tfsdk:"creation_time"`
 	Description                  types.String                                                 `tfsdk:"description"`
 	EngineType                   fwtypes.StringEnum[awstypes.EngineType]                      `tfsdk:"engine_type"`
 	EngineVersion                types.String                                                 `tfsdk:"engine_version"`
@@ -597,6 +1061,7 @@ type environmentResourceModel struct {
 	InstanceType                 types.String                                                 `tfsdk:"instance_type"`
 	KmsKeyID                     fwtypes.ARN                                                  `tfsdk:"kms_key_id"`
 	LoadBalancerArn              types.String                                                 `tfsdk:"load_balancer_arn"`
+	LoadBalancerDNSName          types.String                                                 `tfsdk:"load_balancer_dns_name"`
 	Name                         types.String                                                 `tfsdk:"name"`
 	PreferredMaintenanceWindow   fwtypes.OnceAWeekWindow                                      `tfsdk:"preferred_maintenance_window"`
 	PubliclyAccessible           types.Bool                                                   `tfsdk:"publicly_accessible"`
@@ -606,6 +1071,7 @@ type environmentResourceModel struct {
 	Tags                         types.Map                                                    `tfsdk:"tags"`
 	TagsAll                      types.Map                                                    `tfsdk:"tags_all"`
 	Timeouts                     timeouts.Value                                               `tfsdk:"timeouts"`
+	VpcID                        types.String                                                 `tfsdk:"vpc_id"`
 }
 
 func (model *environmentResourceModel) InitFromID() error {
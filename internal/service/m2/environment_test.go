@@ -5,16 +5,33 @@ package m2_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -43,7 +60,9 @@ func TestAccM2Environment_basic(t *testing.T) {
 				Config: testAccEnvironmentConfig_basic(rName, "bluage"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckEnvironmentExists(ctx, resourceName, &environment),
+					resource.TestCheckResourceAttrSet(resourceName, "actual_capacity"),
 					resource.TestCheckNoResourceAttr(resourceName, "apply_changes_during_maintenance_window"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationTime),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "m2", regexache.MustCompile(`env/+.`)),
 					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
 					resource.TestCheckResourceAttr(resourceName, "engine_type", "bluage"),
@@ -54,6 +73,7 @@ func TestAccM2Environment_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "M2.m5.large"),
 					resource.TestCheckNoResourceAttr(resourceName, names.AttrKMSKeyID),
 					resource.TestCheckResourceAttrSet(resourceName, "load_balancer_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "load_balancer_dns_name"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrPreferredMaintenanceWindow),
 					resource.TestCheckResourceAttr(resourceName, names.AttrPubliclyAccessible, acctest.CtFalse),
@@ -61,6 +81,7 @@ func TestAccM2Environment_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "storage_configuration.#", acctest.Ct0),
 					acctest.CheckResourceAttrGreaterThanValue(resourceName, "subnet_ids.#", 0),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrVPCID),
 				),
 			},
 			{
@@ -68,6 +89,12 @@ func TestAccM2Environment_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccEnvironmentImportStateIDByNameFunc(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -171,6 +198,7 @@ func TestAccM2Environment_full(t *testing.T) {
 				Config: testAccEnvironmentConfig_full(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckEnvironmentExists(ctx, resourceName, &environment),
+					resource.TestCheckResourceAttrSet(resourceName, "actual_capacity"),
 					resource.TestCheckNoResourceAttr(resourceName, "apply_changes_during_maintenance_window"),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "m2", regexache.MustCompile(`env/+.`)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Test-1"),
@@ -183,6 +211,7 @@ func TestAccM2Environment_full(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "M2.m5.large"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrKMSKeyID),
 					resource.TestCheckResourceAttrSet(resourceName, "load_balancer_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "load_balancer_dns_name"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrPreferredMaintenanceWindow),
 					resource.TestCheckResourceAttr(resourceName, names.AttrPubliclyAccessible, acctest.CtFalse),
@@ -190,6 +219,7 @@ func TestAccM2Environment_full(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "storage_configuration.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", acctest.Ct2),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrVPCID, "aws_vpc.test", names.AttrID),
 				),
 			},
 			{
@@ -201,6 +231,50 @@ func TestAccM2Environment_full(t *testing.T) {
 	})
 }
 
+func TestAccM2Environment_kmsKeyID(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_environment.test"
+	var environment m2.GetEnvironmentOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_kmsKeyID(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyID, "aws_kms_key.test.0", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The key in AWS no longer matches configuration, so the environment must be replaced.
+				Config: testAccEnvironmentConfig_kmsKeyID(rName, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyID, "aws_kms_key.test.1", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func TestAccM2Environment_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -244,6 +318,48 @@ func TestAccM2Environment_update(t *testing.T) {
 	})
 }
 
+func TestAccM2Environment_instanceType(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var environment m2.GetEnvironmentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_update(rName, "M2.m5.large", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "M2.m5.large"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_update(rName, "M2.m5.xlarge", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "M2.m5.xlarge"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccM2Environment_efs(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -319,6 +435,568 @@ func TestAccM2Environment_fsx(t *testing.T) {
 	})
 }
 
+func TestAccM2Environment_subnetsInDifferentVPCs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEnvironmentConfig_subnetsInDifferentVPCs(rName),
+				ExpectError: regexache.MustCompile(`subnet_ids must all belong to the same VPC, found subnets in 2 VPCs`),
+			},
+		},
+	})
+}
+
+func TestMaintenanceWindowMinDurationValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value       string
+		expectError bool
+	}{
+		"valid": {
+			value: "sun:23:00-sun:23:30",
+		},
+		"valid longer": {
+			value: "tue:03:00-tue:07:00",
+		},
+		"valid upper case": {
+			value: "SUN:23:00-SUN:23:30",
+		},
+		"valid wrapping week": {
+			value: "sat:23:45-sun:00:15",
+		},
+		"too short": {
+			value:       "sun:23:00-sun:23:15",
+			expectError: true,
+		},
+		"empty range": {
+			value:       "sun:23:00-sun:23:00",
+			expectError: true,
+		},
+		"too short wrapping week": {
+			value:       "sat:23:50-sun:00:10",
+			expectError: true,
+		},
+		"invalid day": {
+			value:       "sno:23:00-sun:23:30",
+			expectError: true,
+		},
+		"invalid hour": {
+			value:       "sun:24:00-mon:00:30",
+			expectError: true,
+		},
+		"invalid minute": {
+			value:       "sun:23:60-mon:00:30",
+			expectError: true,
+		},
+		"missing end": {
+			value:       "sun:23:00",
+			expectError: true,
+		},
+		"missing minutes": {
+			value:       "sun:23-sun:23:30",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			value := fwtypes.OnceAWeekWindowValue(testCase.value)
+
+			// Format is validated by the custom type, duration by the validator.
+			typeResponse := xattr.ValidateAttributeResponse{}
+			value.ValidateAttribute(ctx, xattr.ValidateAttributeRequest{Path: path.Root("test")}, &typeResponse)
+
+			request := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue(testCase.value),
+			}
+			response := validator.StringResponse{}
+			tfm2.MaintenanceWindowMinDurationValidator(30*time.Minute).ValidateString(ctx, request, &response)
+
+			diags := append(typeResponse.Diagnostics, response.Diagnostics...)
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
+
+func TestEnvironmentResourceModelFlattenARN(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	arn := "arn:aws:m2:us-west-2:123456789012:env/env-1" //lintignore:AWSAT003,AWSAT005
+
+	var data tfm2.EnvironmentResourceModel
+	if diags := fwflex.Flatten(ctx, &m2.GetEnvironmentOutput{
+		EnvironmentArn: aws.String(arn),
+		EnvironmentId:  aws.String("env-1"),
+	}, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := data.EnvironmentARN.ValueString(), arn; got != want {
+		t.Errorf("EnvironmentARN = %q, want %q", got, want)
+	}
+}
+
+func TestEnvironmentResourceModelFlattenCreationTime(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var data tfm2.EnvironmentResourceModel
+	if diags := fwflex.Flatten(ctx, &m2.GetEnvironmentOutput{
+		CreationTime:  aws.Time(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+		EnvironmentId: aws.String("env-1"),
+	}, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := data.CreationTime.ValueString(), "2024-01-02T03:04:05Z"; got != want {
+		t.Errorf("CreationTime = %q, want %q", got, want)
+	}
+}
+
+func TestValidateSubnetsInSameVPC(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		subnets       []*ec2.Subnet
+		expectedError string
+	}{
+		"same VPC": {
+			subnets: []*ec2.Subnet{
+				{SubnetId: aws_sdkv1.String("subnet-1"), VpcId: aws_sdkv1.String("vpc-1")},
+				{SubnetId: aws_sdkv1.String("subnet-2"), VpcId: aws_sdkv1.String("vpc-1")},
+			},
+		},
+		"different VPCs": {
+			subnets: []*ec2.Subnet{
+				{SubnetId: aws_sdkv1.String("subnet-3"), VpcId: aws_sdkv1.String("vpc-2")},
+				{SubnetId: aws_sdkv1.String("subnet-2"), VpcId: aws_sdkv1.String("vpc-1")},
+				{SubnetId: aws_sdkv1.String("subnet-1"), VpcId: aws_sdkv1.String("vpc-1")},
+			},
+			expectedError: "subnet_ids must all belong to the same VPC, found subnets in 2 VPCs (vpc-1: subnet-1, subnet-2; vpc-2: subnet-3)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfm2.ValidateSubnetsInSameVPC(testCase.subnets)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got, want := err.Error(), testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestEnvironmentUpdateTimeout(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"update": types.StringType,
+	}
+
+	testCases := map[string]struct {
+		timeouts      timeouts.Value
+		engineUpgrade bool
+		expected      time.Duration
+	}{
+		"configuration change default": {
+			timeouts: timeouts.Value{Object: types.ObjectNull(attrTypes)},
+			expected: 30 * time.Minute,
+		},
+		"engine upgrade default": {
+			timeouts:      timeouts.Value{Object: types.ObjectNull(attrTypes)},
+			engineUpgrade: true,
+			expected:      120 * time.Minute,
+		},
+		"configured": {
+			timeouts: timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"update": types.StringValue("3h"),
+			})},
+			engineUpgrade: true,
+			expected:      3 * time.Hour,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfm2.EnvironmentUpdateTimeout(context.Background(), testCase.timeouts, testCase.engineUpgrade), testCase.expected; got != want {
+				t.Errorf("EnvironmentUpdateTimeout() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitEnvironmentUpdated(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses     []mockResponse
+		engineUpgrade bool
+	}{
+		"capacity update": {
+			responses: []mockResponse{
+				{body: `{"environmentId":"env-1","status":"Available","actualCapacity":2}`},
+			},
+		},
+		"engine upgrade": {
+			responses: []mockResponse{
+				{body: `{"environmentId":"env-1","status":"Updating","actualCapacity":2}`},
+				{body: `{"environmentId":"env-1","status":"Updating","actualCapacity":2}`},
+				{body: `{"environmentId":"env-1","status":"Available","actualCapacity":2}`},
+			},
+			engineUpgrade: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			conn, httpClient := newMockClient(t, testCase.responses...)
+			timeout := tfm2.EnvironmentUpdateTimeout(ctx, timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"update": types.StringType})}, testCase.engineUpgrade)
+
+			output, err := tfm2.WaitEnvironmentUpdated(ctx, conn, "env-1", timeout)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToInt32(output.ActualCapacity), int32(2); got != want {
+				t.Errorf("ActualCapacity = %d, want %d", got, want)
+			}
+
+			if got, want := httpClient.requestCount(), len(testCase.responses); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateEngineVersionUpgrade(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current       string
+		target        string
+		expectedError string
+	}{
+		"upgrade": {
+			current: "3.7.0",
+			target:  "3.10.0",
+		},
+		"downgrade": {
+			current:       "3.10.0",
+			target:        "3.7.0",
+			expectedError: "can't be downgraded",
+		},
+		"unavailable": {
+			current:       "3.7.0",
+			target:        "4.0.0",
+			expectedError: "available versions are: 3.7.0, 3.10.0, latest",
+		},
+		"not comparable": {
+			current: "3.7.0",
+			target:  "latest",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			conn, _ := newMockClient(t, mockResponse{
+				body: `{"engineVersions":[{"engineType":"bluage","engineVersion":"3.7.0"},{"engineType":"bluage","engineVersion":"3.10.0"},{"engineType":"bluage","engineVersion":"latest"}]}`,
+			})
+
+			engineVersions, err := tfm2.FindEngineVersionsByEngineType(ctx, conn, awstypes.EngineTypeBluage)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = tfm2.ValidateEngineVersionUpgrade(testCase.current, testCase.target, engineVersions)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got, want := err.Error(), testCase.expectedError; !strings.Contains(got, want) {
+				t.Errorf("error = %q, want to contain %q", got, want)
+			}
+		})
+	}
+}
+
+func TestUpdateEnvironmentEngineVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn, httpClient := newMockClient(t,
+		mockResponse{body: `{"environmentId":"env-1"}`},
+		mockResponse{body: `{"environmentId":"env-1","status":"Updating","engineVersion":"3.7.0"}`},
+		mockResponse{body: `{"environmentId":"env-1","status":"Available","engineVersion":"3.10.0","actualCapacity":1}`},
+	)
+
+	output, err := tfm2.UpdateEnvironmentEngineVersion(ctx, conn, "env-1", "3.10.0", false, false, time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.ToString(output.EngineVersion), "3.10.0"; got != want {
+		t.Errorf("EngineVersion = %q, want %q", got, want)
+	}
+
+	if got, want := httpClient.requestCount(), 3; got != want {
+		t.Errorf("requests = %d, want %d", got, want)
+	}
+
+	// Only the engine version is sent, as the API rejects it together with other changes.
+	for _, field := range []string{"desiredCapacity", "instanceType", "preferredMaintenanceWindow"} {
+		if strings.Contains(httpClient.bodies[0], field) {
+			t.Errorf("UpdateEnvironment request %s contains %q", httpClient.bodies[0], field)
+		}
+	}
+
+	if got, want := httpClient.bodies[0], `"engineVersion":"3.10.0"`; !strings.Contains(got, want) {
+		t.Errorf("UpdateEnvironment request = %s, want to contain %s", got, want)
+	}
+}
+
+func TestFindEnvironmentByID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		response       mockResponse
+		expectedID     string
+		expectNotFound bool
+	}{
+		"found": {
+			response:   mockResponse{body: `{"environmentId":"env-1","name":"test"}`},
+			expectedID: "env-1",
+		},
+		"not found": {
+			response:       mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "environment not found"),
+			expectNotFound: true,
+		},
+		"empty result": {
+			response:       mockResponse{body: `{}`},
+			expectNotFound: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, _ := newMockClient(t, testCase.response)
+
+			output, err := tfm2.FindEnvironmentByID(context.Background(), conn, "env-1")
+
+			if testCase.expectNotFound {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected NotFound error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToString(output.EnvironmentId), testCase.expectedID; got != want {
+				t.Errorf("EnvironmentId = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFindEnvironmentByName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses      []mockResponse
+		expectedID     string
+		expectNotFound bool
+		expectTooMany  bool
+	}{
+		"single": {
+			responses: []mockResponse{
+				{body: `{"environments":[{"environmentId":"env-1","name":"test"}]}`},
+			},
+			expectedID: "env-1",
+		},
+		"none": {
+			responses: []mockResponse{
+				{body: `{"environments":[]}`},
+			},
+			expectNotFound: true,
+		},
+		"multiple pages": {
+			responses: []mockResponse{
+				{body: `{"environments":[{"environmentId":"env-1","name":"test"}],"nextToken":"token"}`},
+				{body: `{"environments":[{"environmentId":"env-2","name":"test"}]}`},
+			},
+			expectTooMany: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			output, err := tfm2.FindEnvironmentByName(context.Background(), conn, "test")
+
+			if got, want := httpClient.requestCount(), len(testCase.responses); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			switch {
+			case testCase.expectNotFound:
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected NotFound error, got %v", err)
+				}
+			case testCase.expectTooMany:
+				if !errors.As(err, new(*tfresource.TooManyResultsError)) {
+					t.Fatalf("expected TooManyResults error, got %v", err)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if got, want := aws.ToString(output.EnvironmentId), testCase.expectedID; got != want {
+					t.Errorf("EnvironmentId = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseEnvironmentImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id           string
+		expectedID   string
+		expectedName string
+		expectError  bool
+	}{
+		"ID": {
+			id:         "env-1",
+			expectedID: "env-1",
+		},
+		"name": {
+			id:           "name=test-environment",
+			expectedName: "test-environment",
+		},
+		"empty name": {
+			id:          "name=",
+			expectError: true,
+		},
+		"extra separator": {
+			id:          "name=test,environment",
+			expectError: true,
+		},
+		"repeated prefix": {
+			id:          "name=name=test",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, environmentName, err := tfm2.ParseEnvironmentImportID(testCase.id)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := id, testCase.expectedID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+			if got, want := environmentName, testCase.expectedName; got != want {
+				t.Errorf("name = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func testAccEnvironmentImportStateIDByNameFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return "name=" + rs.Primary.Attributes[names.AttrName], nil
+	}
+}
+
 func testAccCheckEnvironmentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
@@ -438,6 +1116,39 @@ resource "aws_m2_environment" "test" {
 `, rName, engineType)
 }
 
+func testAccEnvironmentConfig_subnetsInDifferentVPCs(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 2
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  vpc_id            = aws_vpc.test[count.index].id
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test[count.index].cidr_block, 8, 0)
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_m2_environment" "test" {
+  name          = %[1]q
+  engine_type   = "bluage"
+  instance_type = "M2.m5.large"
+  subnet_ids    = aws_subnet.test[*].id
+}
+`, rName))
+}
+
 func testAccEnvironmentConfig_full(rName string) string {
 	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
 resource "aws_m2_environment" "test" {
@@ -462,6 +1173,26 @@ resource "aws_kms_key" "test" {
 `, rName))
 }
 
+func testAccEnvironmentConfig_kmsKeyID(rName string, keyIndex int) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_m2_environment" "test" {
+  name               = %[1]q
+  engine_type        = "bluage"
+  instance_type      = "M2.m5.large"
+  kms_key_id         = aws_kms_key.test[%[2]d].arn
+  security_group_ids = [aws_security_group.test.id]
+  subnet_ids         = aws_subnet.test[*].id
+}
+
+resource "aws_kms_key" "test" {
+  count = 2
+
+  description             = "%[1]s-${count.index}"
+  deletion_window_in_days = 7
+}
+`, rName, keyIndex))
+}
+
 func testAccEnvironmentConfig_update(rName, instanceType string, desiredCapacity int32) string {
 	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
 resource "aws_m2_environment" "test" {
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	errCodeNoSuchBucket = "NoSuchBucket"
+)
+
+// isDefinitionError returns whether the error is a ValidationException caused by an invalid application definition.
+func isDefinitionError(err error) bool {
+	e, ok := errs.As[*awstypes.ValidationException](err)
+	if !ok {
+		return false
+	}
+
+	for _, field := range e.FieldList {
+		if containsFold(aws.ToString(field.Name), "definition") {
+			return true
+		}
+	}
+
+	return containsFold(e.ErrorMessage(), "definition")
+}
+
+// isS3LocationError returns whether the error is a ValidationException caused by an invalid or inaccessible S3 location.
+func isS3LocationError(err error) bool {
+	e, ok := errs.As[*awstypes.ValidationException](err)
+	if !ok {
+		return false
+	}
+
+	message := e.ErrorMessage()
+
+	return containsFold(message, "s3 uri") || containsFold(message, "s3 location") || containsFold(message, "s3://")
+}
+
+// isNameConflictError returns whether the error is caused by another resource already having the requested name.
+// A ConflictException is also returned when the resource's state doesn't allow the operation, so it's classified by its message.
+func isNameConflictError(err error) bool {
+	if e, ok := errs.As[*awstypes.ConflictException](err); ok {
+		return containsFold(e.ErrorMessage(), "already exists")
+	}
+
+	e, ok := errs.As[*awstypes.ValidationException](err)
+
+	return ok && containsFold(e.ErrorMessage(), "already exists")
+}
+
+// isRolePropagationError returns whether the error is caused by a newly created IAM role not yet being usable by Mainframe Modernization.
+func isRolePropagationError(err error) bool {
+	return errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "does not have proper Trust Policy for M2 service")
+}
+
+// retryWhenRoleNotPropagated retries the specified function while it fails because of IAM role propagation.
+// Other errors, and propagation errors once the timeout has expired, aren't retried.
+func retryWhenRoleNotPropagated[T any](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return tfresource.RetryGWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if isRolePropagationError(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// isLimitExceededError returns whether the error is caused by a service quota being reached or by the request being throttled.
+func isLimitExceededError(err error) bool {
+	return isServiceQuotaExceededError(err) || errs.IsA[*awstypes.ThrottlingException](err)
+}
+
+// isServiceQuotaExceededError returns whether the error is caused by a Mainframe Modernization service quota, such as the
+// maximum number of applications or environments, being reached.
+func isServiceQuotaExceededError(err error) bool {
+	return errs.IsA[*awstypes.ServiceQuotaExceededException](err)
+}
+
+// serviceQuotaExceededErrorDetail returns the detail of a service quota error, naming the quota and linking to it in the Service Quotas console.
+func serviceQuotaExceededErrorDetail(err error) string {
+	e, ok := errs.As[*awstypes.ServiceQuotaExceededException](err)
+	if !ok {
+		return err.Error()
+	}
+
+	quota := "A Mainframe Modernization service quota"
+	if v := aws.ToString(e.ResourceType); v != "" {
+		quota = fmt.Sprintf("The Mainframe Modernization service quota for %s resources", v)
+	}
+
+	serviceCode := aws.ToString(e.ServiceCode)
+	if serviceCode == "" {
+		serviceCode = names.M2
+	}
+
+	url := fmt.Sprintf("https://console.aws.amazon.com/servicequotas/home/services/%s/quotas", serviceCode)
+	if v := aws.ToString(e.QuotaCode); v != "" {
+		quota += fmt.Sprintf(" (quota code %s)", v)
+		url += "/" + v
+	}
+
+	return fmt.Sprintf("%s\n\n%s has been reached. Delete unused resources or request a quota increase: %s", err.Error(), quota, url)
+}
+
+// retryWhenLimitExceeded retries the specified function while it fails because a service quota has been reached or the request is throttled.
+// Quotas are often only reached transiently, while other resources are being deleted concurrently.
+func retryWhenLimitExceeded[T any](ctx context.Context, timeout time.Duration, f func() (T, error)) (T, error) {
+	return tfresource.RetryGWhen(ctx, timeout, f, func(err error) (bool, error) {
+		if isLimitExceededError(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// applicationErrorDiagnostic returns a diagnostic for an application create or update error.
+// Classified errors are attached to the responsible attribute with a hint on how to fix them.
+// S3 location errors are attached to whichever of s3_location or s3_bucket the specified definition is configured with.
+// None of them are retryable, as they are caused by configuration or by a service quota having been reached.
+func applicationErrorDiagnostic(summary string, err error, definitionData *definitionModel) diag.Diagnostic {
+	switch {
+	case isServiceQuotaExceededError(err):
+		return diag.NewErrorDiagnostic(summary, serviceQuotaExceededErrorDetail(err))
+	case isS3LocationError(err) && definitionData != nil && !definitionData.S3Bucket.IsNull():
+		return diag.NewAttributeErrorDiagnostic(path.Root("definition").AtListIndex(0).AtName("s3_bucket"), summary, err.Error()+"\n\nCheck that the object at s3_bucket and s3_key exists.")
+	case isS3LocationError(err):
+		return diag.NewAttributeErrorDiagnostic(path.Root("definition").AtListIndex(0).AtName("s3_location"), summary, err.Error()+"\n\nCheck that the S3 location has the form s3://bucket/key and that the object exists.")
+	case isDefinitionError(err):
+		return diag.NewAttributeErrorDiagnostic(path.Root("definition"), summary, err.Error()+"\n\nCheck that the application definition is valid for the engine type.")
+	case isNameConflictError(err):
+		return diag.NewAttributeErrorDiagnostic(path.Root(names.AttrName), summary, err.Error()+"\n\nApplication names must be unique within the account and Region.")
+	default:
+		return diag.NewErrorDiagnostic(summary, err.Error())
+	}
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// environmentErrorDiagnostic returns a diagnostic for an environment create error.
+// Service quota and throttling errors are returned once retrying has timed out, with a hint on how to fix them.
+func environmentErrorDiagnostic(summary string, err error) diag.Diagnostic {
+	if isServiceQuotaExceededError(err) {
+		return diag.NewErrorDiagnostic(summary, serviceQuotaExceededErrorDetail(err))
+	}
+
+	if isLimitExceededError(err) {
+		return diag.NewErrorDiagnostic(summary, err.Error()+"\n\nRequests are being throttled. Create fewer environments at once.")
+	}
+
+	return diag.NewErrorDiagnostic(summary, err.Error())
+}
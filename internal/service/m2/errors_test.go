@@ -0,0 +1,416 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestErrorClassification(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                string
+		err                 error
+		definition          *tfm2.DefinitionModel
+		expectDefinition    bool
+		expectS3Location    bool
+		expectNameConflict  bool
+		expectAttributePath path.Path
+	}{
+		{
+			name: "definition message",
+			err: &awstypes.ValidationException{
+				Message: aws.String("Application definition is not valid JSON"),
+			},
+			expectDefinition:    true,
+			expectAttributePath: path.Root("definition"),
+		},
+		{
+			name: "definition field",
+			err: &awstypes.ValidationException{
+				Message: aws.String("1 validation error detected"),
+				FieldList: []awstypes.ValidationExceptionField{
+					{Name: aws.String("definition.content"), Message: aws.String("must not be blank")},
+				},
+			},
+			expectDefinition:    true,
+			expectAttributePath: path.Root("definition"),
+		},
+		{
+			name: "bad S3 URI",
+			err: &awstypes.ValidationException{
+				Message: aws.String("Invalid S3 URI: bucket/key"),
+			},
+			expectS3Location:    true,
+			expectAttributePath: path.Root("definition").AtListIndex(0).AtName("s3_location"),
+		},
+		{
+			name: "S3 object from bucket and key",
+			err: &awstypes.ValidationException{
+				Message: aws.String("Unable to read definition from s3://bucket/key"),
+			},
+			definition: &tfm2.DefinitionModel{
+				S3Bucket: types.StringValue("bucket"),
+				S3Key:    types.StringValue("key"),
+			},
+			expectDefinition:    true,
+			expectS3Location:    true,
+			expectAttributePath: path.Root("definition").AtListIndex(0).AtName("s3_bucket"),
+		},
+		{
+			name: "S3 location in definition",
+			err: &awstypes.ValidationException{
+				Message: aws.String("Unable to read definition from s3://bucket/key"),
+			},
+			expectDefinition:    true,
+			expectS3Location:    true,
+			expectAttributePath: path.Root("definition").AtListIndex(0).AtName("s3_location"),
+		},
+		{
+			name: "name collision validation",
+			err: &awstypes.ValidationException{
+				Message: aws.String("Application with name test already exists"),
+			},
+			expectNameConflict:  true,
+			expectAttributePath: path.Root(names.AttrName),
+		},
+		{
+			name: "name collision conflict",
+			err: &awstypes.ConflictException{
+				Message: aws.String("Application test already exists"),
+			},
+			expectNameConflict:  true,
+			expectAttributePath: path.Root(names.AttrName),
+		},
+		{
+			name: "state conflict",
+			err: &awstypes.ConflictException{
+				Message: aws.String("Application app is being updated and can't be updated until the current operation completes"),
+			},
+		},
+		{
+			name: "wrapped",
+			err: fmt.Errorf("creating: %w", &awstypes.ValidationException{
+				Message: aws.String("Invalid application definition"),
+			}),
+			expectDefinition:    true,
+			expectAttributePath: path.Root("definition"),
+		},
+		{
+			name: "other validation",
+			err: &awstypes.ValidationException{
+				Message: aws.String("Role ARN is not valid"),
+			},
+		},
+		{
+			name: "other error",
+			err:  errors.New("definition already exists in s3://bucket"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfm2.IsDefinitionError(testCase.err), testCase.expectDefinition; got != want {
+				t.Errorf("IsDefinitionError = %t, want %t", got, want)
+			}
+
+			if got, want := tfm2.IsS3LocationError(testCase.err), testCase.expectS3Location; got != want {
+				t.Errorf("IsS3LocationError = %t, want %t", got, want)
+			}
+
+			if got, want := tfm2.IsNameConflictError(testCase.err), testCase.expectNameConflict; got != want {
+				t.Errorf("IsNameConflictError = %t, want %t", got, want)
+			}
+
+			diagnostic := tfm2.ApplicationErrorDiagnostic("summary", testCase.err, testCase.definition)
+
+			if got, want := diagnostic.Summary(), "summary"; got != want {
+				t.Errorf("Summary = %q, want %q", got, want)
+			}
+
+			if len(testCase.expectAttributePath.Steps()) == 0 {
+				if _, ok := diagnostic.(interface{ Path() path.Path }); ok {
+					t.Errorf("diagnostic has an attribute path, want none")
+				}
+
+				return
+			}
+
+			withPath, ok := diagnostic.(interface{ Path() path.Path })
+			if !ok {
+				t.Fatalf("diagnostic has no attribute path, want %s", testCase.expectAttributePath)
+			}
+
+			if got, want := withPath.Path(), testCase.expectAttributePath; !got.Equal(want) {
+				t.Errorf("Path = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestRetryWhenRoleNotPropagated(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses   []mockResponse
+		expectError bool
+	}{
+		"propagated on retry": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusForbidden, "AccessDeniedException", "Role arn:aws:iam::123456789012:role/test does not have proper Trust Policy for M2 service"),
+				{body: `{"applicationArn":"arn","applicationId":"app-1","applicationVersion":1}`},
+			},
+		},
+		"permission error": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusForbidden, "AccessDeniedException", "User is not authorized to perform: m2:CreateApplication"),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			output, err := tfm2.RetryWhenRoleNotPropagated(ctx, time.Minute, func() (*m2.CreateApplicationOutput, error) {
+				return conn.CreateApplication(ctx, &m2.CreateApplicationInput{
+					ClientToken: aws.String("token"),
+					Definition:  &awstypes.DefinitionMemberContent{Value: "{}"},
+					EngineType:  awstypes.EngineTypeBluage,
+					Name:        aws.String("test"),
+				})
+			})
+
+			if got, want := httpClient.requestCount(), len(testCase.responses); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			if testCase.expectError {
+				if !errs.IsA[*awstypes.AccessDeniedException](err) {
+					t.Fatalf("expected AccessDeniedException, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToString(output.ApplicationId), "app-1"; got != want {
+				t.Errorf("ApplicationId = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestRetryWhenLimitExceeded(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses   []mockResponse
+		expectError bool
+	}{
+		"quota available on retry": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusTooManyRequests, "ThrottlingException", "Rate exceeded"),
+				mockErrorResponse(http.StatusPaymentRequired, "ServiceQuotaExceededException", "Environment quota exceeded"),
+				{body: `{"environmentId":"env-1"}`},
+			},
+		},
+		"limit message": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusBadRequest, "ValidationException", "LoadBalancerLimitExceeded: The maximum number of load balancers has been reached"),
+			},
+			expectError: true,
+		},
+		"other error": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusBadRequest, "ValidationException", "Invalid instance type"),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			conn, httpClient := newMockClient(t, testCase.responses...)
+
+			output, err := tfm2.RetryWhenLimitExceeded(ctx, time.Minute, func() (*m2.CreateEnvironmentOutput, error) {
+				return conn.CreateEnvironment(ctx, &m2.CreateEnvironmentInput{
+					ClientToken:  aws.String("token"),
+					EngineType:   awstypes.EngineTypeBluage,
+					InstanceType: aws.String("M2.m5.large"),
+					Name:         aws.String("test"),
+				})
+			})
+
+			if got, want := httpClient.requestCount(), len(testCase.responses); got != want {
+				t.Errorf("requests = %d, want %d", got, want)
+			}
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToString(output.EnvironmentId), "env-1"; got != want {
+				t.Errorf("EnvironmentId = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestEnvironmentErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err        error
+		expectHint bool
+	}{
+		"throttling": {
+			err:        &awstypes.ThrottlingException{Message: aws.String("Rate exceeded")},
+			expectHint: true,
+		},
+		"wrapped throttling": {
+			err:        fmt.Errorf("creating: %w", &awstypes.ThrottlingException{Message: aws.String("Rate exceeded")}),
+			expectHint: true,
+		},
+		"limit message": {
+			err: &awstypes.ValidationException{Message: aws.String("NetworkInterfaceLimitExceeded: The maximum number of network interfaces has been reached")},
+		},
+		"other error": {
+			err: &awstypes.ValidationException{Message: aws.String("Invalid instance type")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfm2.IsLimitExceededError(testCase.err), testCase.expectHint; got != want {
+				t.Errorf("IsLimitExceededError = %t, want %t", got, want)
+			}
+
+			diagnostic := tfm2.EnvironmentErrorDiagnostic("summary", testCase.err)
+
+			if got, want := strings.Contains(diagnostic.Detail(), "Requests are being throttled"), testCase.expectHint; got != want {
+				t.Errorf("hint = %t, want %t: %s", got, want, diagnostic.Detail())
+			}
+		})
+	}
+}
+
+func TestServiceQuotaExceededErrorDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err            error
+		expectQuota    bool
+		expectedDetail []string
+	}{
+		"environments quota": {
+			err: &awstypes.ServiceQuotaExceededException{
+				Message:      aws.String("Environment quota exceeded"),
+				QuotaCode:    aws.String("L-12345678"),
+				ResourceType: aws.String("environment"),
+				ServiceCode:  aws.String("m2"),
+			},
+			expectQuota: true,
+			expectedDetail: []string{
+				"Environment quota exceeded",
+				"service quota for environment resources (quota code L-12345678) has been reached",
+				"https://console.aws.amazon.com/servicequotas/home/services/m2/quotas/L-12345678",
+			},
+		},
+		"no quota code": {
+			err:         &awstypes.ServiceQuotaExceededException{Message: aws.String("Application quota exceeded")},
+			expectQuota: true,
+			expectedDetail: []string{
+				"A Mainframe Modernization service quota has been reached",
+				"https://console.aws.amazon.com/servicequotas/home/services/m2/quotas",
+			},
+		},
+		"wrapped": {
+			err: fmt.Errorf("creating: %w", &awstypes.ServiceQuotaExceededException{
+				Message:   aws.String("Application quota exceeded"),
+				QuotaCode: aws.String("L-87654321"),
+			}),
+			expectQuota:    true,
+			expectedDetail: []string{"quotas/L-87654321"},
+		},
+		"load balancer limit": {
+			err: &awstypes.ValidationException{Message: aws.String("LoadBalancerLimitExceeded: The maximum number of load balancers has been reached")},
+		},
+		"other error": {
+			err: &awstypes.ValidationException{Message: aws.String("Invalid instance type")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfm2.IsServiceQuotaExceededError(testCase.err), testCase.expectQuota; got != want {
+				t.Errorf("IsServiceQuotaExceededError = %t, want %t", got, want)
+			}
+
+			// Service quota errors are retried, and only classified by their type.
+			if got, want := tfm2.IsLimitExceededError(testCase.err), testCase.expectQuota; got != want {
+				t.Errorf("IsLimitExceededError = %t, want %t", got, want)
+			}
+
+			for _, diagnostic := range []string{
+				tfm2.ApplicationErrorDiagnostic("summary", testCase.err, nil).Detail(),
+				tfm2.EnvironmentErrorDiagnostic("summary", testCase.err).Detail(),
+			} {
+				if got, want := strings.Contains(diagnostic, "service quota"), testCase.expectQuota; got != want {
+					t.Errorf("service quota hint = %t, want %t: %s", got, want, diagnostic)
+				}
+
+				for _, want := range testCase.expectedDetail {
+					if !strings.Contains(diagnostic, want) {
+						t.Errorf("detail = %q, want to contain %q", diagnostic, want)
+					}
+				}
+			}
+		})
+	}
+}
@@ -3,13 +3,110 @@
 
 package m2
 
+import (
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+)
+
 // Exports for use in tests only.
 var (
-	ResourceApplication = newApplicationResource
-	ResourceDeployment  = newDeploymentResource
-	ResourceEnvironment = newEnvironmentResource
+	ResourceApplication                       = newApplicationResource
+	ResourceApplicationEnvironmentAssociation = newApplicationEnvironmentAssociationResource
+	ResourceBatchJobExecution                 = newBatchJobExecutionResource
+	ResourceDeployment                        = newDeploymentResource
+	ResourceEnvironment                       = newEnvironmentResource
+
+	ApplicationCreateTimeout                          = applicationCreateTimeout
+	ApplicationDefinitionMissingFields                = applicationDefinitionMissingFields
+	ApplicationDefinitionRequiresRole                 = applicationDefinitionRequiresRole
+	ApplicationErrorDiagnostic                        = applicationErrorDiagnostic
+	ApplicationUpdateCreatesVersion                   = applicationUpdateCreatesVersion
+	AssociateApplicationWithEnvironment               = associateApplicationWithEnvironment
+	CancelTimedOutBatchJobExecution                   = cancelTimedOutBatchJobExecution
+	CheckApplicationNameAvailable                     = checkApplicationNameAvailable
+	CreateDeployment                                  = createDeployment
+	DecodeDefinitionContentBase64                     = decodeDefinitionContentBase64
+	DefinitionBlockCountValidator                     = definitionBlockCountValidator
+	DefinitionContentFileHash                         = definitionContentFileHash
+	DefinitionContentMaxLengthValidator               = definitionContentMaxLengthValidator
+	DefinitionContentSizeWarningValidator             = definitionContentSizeWarningValidator
+	DefinitionRedeploymentWarnings                    = definitionRedeploymentWarnings
+	DefinitionS3Location                              = definitionS3Location
+	DefinitionS3LocationValidator                     = definitionS3LocationValidator
+	DefinitionS3LocationWarnings                      = definitionS3LocationWarnings
+	DefinitionS3ObjectVersionValidator                = definitionS3ObjectVersionValidator
+	DefinitionUseStateWhenUnchanged                   = definitionUseStateWhenUnchanged
+	DeleteApplication                                 = deleteApplication
+	DeleteStagedDefinitionContent                     = deleteStagedDefinitionContent
+	DeploymentResourceModelSetDeployedVersionDrift    = (*deploymentResourceModel).setDeployedVersionDrift
+	DisassociateApplicationFromEnvironment            = disassociateApplicationFromEnvironment
+	EnvironmentErrorDiagnostic                        = environmentErrorDiagnostic
+	EnvironmentUpdateTimeout                          = environmentUpdateTimeout
+	ExpandApplicationVersion                          = expandApplicationVersion
+	ExpandDefinition                                  = expandDefinition
+	FindApplicationByID                               = findApplicationByID
+	FindApplicationByIDSettingTagsOut                 = findApplicationByIDSettingTagsOut
+	FindApplicationEnvironmentAssociationByTwoPartKey = findApplicationEnvironmentAssociationByTwoPartKey
+	FindApplicationVersionByCurrentOrLatest           = findApplicationVersionByCurrentOrLatest
+	FindApplicationVersionByTwoPartKey                = findApplicationVersionByTwoPartKey
+	FindApplicationVersionByTwoPartKeyWithRetry       = findApplicationVersionByTwoPartKeyWithRetry
+	FindApplicationsByEngineTypeAndNamePrefix         = findApplicationsByEngineTypeAndNamePrefix
+	FindBatchJobExecutionByTwoPartKey                 = findBatchJobExecutionByTwoPartKey
+	FindDataSetsByEnvironmentIDAndNamePrefix          = findDataSetsByEnvironmentIDAndNamePrefix
+	FindDeployedEnvironmentIDsByApplicationID         = findDeployedEnvironmentIDsByApplicationID
+	FindDeploymentByTwoPartKey                        = findDeploymentByTwoPartKey
+	FindDeploymentsByEnvironmentID                    = findDeploymentsByEnvironmentID
+	FindEngineVersionsByEngineType                    = findEngineVersionsByEngineType
+	FindEnvironmentByID                               = findEnvironmentByID
+	FindEnvironmentByName                             = findEnvironmentByName
+	FlattenApplicationARN                             = flattenApplicationARN
+	FlattenApplicationVersion                         = flattenApplicationVersion
+	FlattenDefinitionStagedS3Location                 = flattenDefinitionStagedS3Location
+	FlattenDeployedEnvironmentID                      = flattenDeployedEnvironmentID
+	IsDefinitionError                                 = isDefinitionError
+	IsLimitExceededError                              = isLimitExceededError
+	IsNameConflictError                               = isNameConflictError
+	IsS3LocationError                                 = isS3LocationError
+	IsServiceQuotaExceededError                       = isServiceQuotaExceededError
+	MaintenanceWindowMinDurationValidator             = maintenanceWindowMinDurationValidator
+	NormalizeDefinitionS3Location                     = normalizeDefinitionS3Location
+	ParseApplicationImportID                          = parseApplicationImportID
+	ParseEnvironmentImportID                          = parseEnvironmentImportID
+	RedeployDeploymentModel                           = redeployDeploymentModel
+	RetryWhenLimitExceeded                            = retryWhenLimitExceeded[*m2.CreateEnvironmentOutput]
+	RetryWhenRoleNotPropagated                        = retryWhenRoleNotPropagated[*m2.CreateApplicationOutput]
+	RoleARNAccountDiagnostics                         = roleARNAccountDiagnostics
+	RoleARNPartitionDiagnostics                       = roleARNPartitionDiagnostics
+	RoleTrustPolicyAllowsService                      = roleTrustPolicyAllowsService
+	SetApplicationCreateOutputState                   = setApplicationCreateOutputState
+	SetApplicationReadOutputState                     = setApplicationReadOutputState
+	StageDefinitionContent                            = stageDefinitionContent
+	StatusApplicationVersion                          = statusApplicationVersion
+	StopApplication                                   = stopApplication
+	StopApplicationBeforeDelete                       = stopApplicationBeforeDelete
+	UpdateApplicationDescription                      = updateApplicationDescription
+	UpdateEnvironmentEngineVersion                    = updateEnvironmentEngineVersion
+	UpdateTags                                        = updateTags
+	ValidateEngineVersionUpgrade                      = validateEngineVersionUpgrade
+	ValidateSubnetsInSameVPC                          = validateSubnetsInSameVPC
+	WaitApplicationCreated                            = waitApplicationCreated
+	WaitApplicationCreatedIfReady                     = waitApplicationCreatedIfReady
+	WaitApplicationDeleted                            = waitApplicationDeleted
+	WaitApplicationDeletedFromEnvironment             = waitApplicationDeletedFromEnvironment
+	WaitApplicationRunning                            = waitApplicationRunning
+	WaitApplicationStopped                            = waitApplicationStopped
+	WaitApplicationUpdatable                          = waitApplicationUpdatable
+	WaitApplicationUpdated                            = waitApplicationUpdated
+	WaitBatchJobExecutionCompleted                    = waitBatchJobExecutionCompleted
+	WaitDeploymentCreated                             = waitDeploymentCreated
+	WaitDeploymentUpdated                             = waitDeploymentUpdated
+	WaitEnvironmentCreated                            = waitEnvironmentCreated
+	WaitEnvironmentUpdated                            = waitEnvironmentUpdated
+)
 
-	FindApplicationByID        = findApplicationByID
-	FindDeploymentByTwoPartKey = findDeploymentByTwoPartKey
-	FindEnvironmentByID        = findEnvironmentByID
+type (
+	ApplicationResourceModel       = applicationResourceModel
+	BatchJobExecutionResourceModel = batchJobExecutionResourceModel
+	DefinitionModel                = definitionModel
+	DeploymentResourceModel        = deploymentResourceModel
+	EnvironmentResourceModel       = environmentResourceModel
 )
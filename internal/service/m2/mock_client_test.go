@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// mockResponse is a canned AWS API response.
+type mockResponse struct {
+	statusCode int
+	errorType  string
+	header     map[string]string
+	body       string
+}
+
+// mockErrorResponse returns a canned Mainframe Modernization API error response.
+func mockErrorResponse(statusCode int, errorType, message string) mockResponse {
+	return mockResponse{
+		statusCode: statusCode,
+		errorType:  errorType,
+		body:       `{"message":"` + message + `"}`,
+	}
+}
+
+// mockHTTPClient serves canned responses, in order, recording each request.
+type mockHTTPClient struct {
+	t         *testing.T
+	mu        sync.Mutex
+	responses []mockResponse
+	requests  []*http.Request
+	bodies    []string
+}
+
+func (c *mockHTTPClient) Do(request *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.requests = append(c.requests, request)
+
+	var body []byte
+	if request.Body != nil {
+		var err error
+		if body, err = io.ReadAll(request.Body); err != nil {
+			return nil, err
+		}
+	}
+	c.bodies = append(c.bodies, string(body))
+
+	if len(c.responses) == 0 {
+		c.t.Errorf("unexpected request: %s %s", request.Method, request.URL.Path)
+
+		return nil, errors.New("no more mock responses")
+	}
+
+	response := c.responses[0]
+	c.responses = c.responses[1:]
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	if response.errorType != "" {
+		header.Set("X-Amzn-Errortype", response.errorType)
+	}
+	for k, v := range response.header {
+		header.Set(k, v)
+	}
+
+	statusCode := response.statusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(response.body)),
+		Request:    request,
+	}, nil
+}
+
+// requestCount returns the number of requests made.
+func (c *mockHTTPClient) requestCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.requests)
+}
+
+// newMockClient returns a Mainframe Modernization API client that is served the specified responses, in order.
+func newMockClient(t *testing.T, responses ...mockResponse) (*m2.Client, *mockHTTPClient) {
+	t.Helper()
+
+	httpClient := &mockHTTPClient{
+		t:         t,
+		responses: responses,
+	}
+
+	client := m2.New(m2.Options{
+		Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:  httpClient,
+		Region:      "us-west-2", //lintignore:AWSAT003
+		Retryer:     aws.NopRetryer{},
+	})
+
+	return client, httpClient
+}
+
+// newMockS3Client returns an S3 API client that is served the specified responses, in order.
+func newMockS3Client(t *testing.T, responses ...mockResponse) (*s3.Client, *mockHTTPClient) {
+	t.Helper()
+
+	httpClient := &mockHTTPClient{
+		t:         t,
+		responses: responses,
+	}
+
+	client := s3.New(s3.Options{
+		Credentials:  credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		HTTPClient:   httpClient,
+		Region:       "us-west-2", //lintignore:AWSAT003
+		Retryer:      aws.NopRetryer{},
+		UsePathStyle: true,
+	})
+
+	return client, httpClient
+}
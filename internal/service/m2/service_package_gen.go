@@ -16,11 +16,36 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newApplicationVersionDataSource,
+			Name:    "Application Version",
+		},
+		{
+			Factory: newApplicationsDataSource,
+			Name:    "Applications",
+		},
+		{
+			Factory: newBatchJobExecutionsDataSource,
+			Name:    "Batch Job Executions",
+		},
+		{
+			Factory: newDataSetsDataSource,
+			Name:    "Data Sets",
+		},
+		{
+			Factory: newDeploymentsDataSource,
+			Name:    "Deployments",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newApplicationEnvironmentAssociationResource,
+			Name:    "Application Environment Association",
+		},
 		{
 			Factory: newApplicationResource,
 			Name:    "Application",
@@ -28,6 +53,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newBatchJobExecutionResource,
+			Name:    "Batch Job Execution",
+		},
 		{
 			Factory: newDeploymentResource,
 			Name:    "Deployment",
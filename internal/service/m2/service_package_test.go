@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// TestClientUserAgent verifies that the provider-wide TF_APPEND_USER_AGENT suffix is sent on Mainframe Modernization requests.
+func TestClientUserAgent(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	ctx := context.Background()
+	const suffix = "Team/billing-1234 (cost attribution)"
+
+	t.Setenv("TF_APPEND_USER_AGENT", suffix)
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(map[string]any{
+		names.AttrAccessKey:                 servicemocks.MockStaticAccessKey,
+		names.AttrSecretKey:                 servicemocks.MockStaticSecretKey,
+		names.AttrRegion:                    "us-west-2", //lintignore:AWSAT003
+		names.AttrSkipCredentialsValidation: true,
+		names.AttrSkipRequestingAccountID:   true,
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	conn := p.Meta().(*conns.AWSClient).M2Client(ctx)
+
+	var userAgent string
+	_, err = conn.ListApplications(ctx, &m2.ListApplicationsInput{}, func(o *m2.Options) {
+		o.APIOptions = append(o.APIOptions,
+			func(stack *middleware.Stack) error {
+				return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc(
+					"Test: Retrieve User-Agent",
+					func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+						if request, ok := in.Request.(*smithyhttp.Request); ok {
+							userAgent = request.Header.Get("User-Agent")
+						}
+
+						return next.HandleFinalize(ctx, in)
+					}), middleware.After)
+			},
+			addCancelRequestMiddleware(),
+		)
+	})
+
+	if !errors.Is(err, errCancelOperation) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasSuffix(userAgent, suffix) {
+		t.Errorf("User-Agent = %q, want suffix %q", userAgent, suffix)
+	}
+}
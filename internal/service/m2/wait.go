@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// waiterStats records the refreshes made by a waiter, so that a slow create or update can be told apart from the provider retrying.
+// The waiter refreshes in a goroutine that may still be running once it has timed out, so access is synchronized.
+type waiterStats struct {
+	mu            sync.Mutex
+	polls         int
+	notFoundPolls int
+	start         time.Time
+}
+
+// refresh returns the specified refresh function, counting each call to it.
+// A refresh that returns no result and no error is counted as a not found poll, which the waiter retries.
+func (s *waiterStats) refresh(f retry.StateRefreshFunc) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		s.mu.Lock()
+		if s.start.IsZero() {
+			s.start = time.Now()
+		}
+		s.polls++
+		s.mu.Unlock()
+
+		output, status, err := f()
+
+		if output == nil && err == nil {
+			s.mu.Lock()
+			s.notFoundPolls++
+			s.mu.Unlock()
+		}
+
+		return output, status, err
+	}
+}
+
+// log logs a summary of the refreshes made while waiting for an operation on the specified resource.
+func (s *waiterStats) log(ctx context.Context, operation, id, status string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fields := map[string]any{
+		"operation":       operation,
+		"resource_id":     id,
+		"polls":           s.polls,
+		"not_found_polls": s.notFoundPolls,
+		"final_status":    status,
+		"succeeded":       err == nil,
+	}
+
+	if !s.start.IsZero() {
+		fields["elapsed"] = time.Since(s.start).Round(time.Millisecond).String()
+	}
+
+	tflog.Info(ctx, "Mainframe Modernization waiter summary", fields)
+}
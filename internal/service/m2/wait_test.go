@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+)
+
+func TestWaiterSummaryLogged(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses             []mockResponse
+		expectedOperation     string
+		expectedPolls         float64
+		expectedNotFoundPolls float64
+		expectedStatus        string
+		expectedSucceeded     bool
+	}{
+		"environment created after not found": {
+			responses: []mockResponse{
+				mockErrorResponse(http.StatusNotFound, "ResourceNotFoundException", "environment not found"),
+				{body: `{"environmentId":"env","status":"Creating"}`},
+				{body: `{"environmentId":"env","status":"Available"}`},
+			},
+			expectedOperation:     "environment create",
+			expectedPolls:         3,
+			expectedNotFoundPolls: 1,
+			expectedStatus:        "Available",
+			expectedSucceeded:     true,
+		},
+		"environment update failed": {
+			responses: []mockResponse{
+				{body: `{"environmentId":"env","status":"Updating"}`},
+				{body: `{"environmentId":"env","status":"Failed","statusReason":"insufficient capacity"}`},
+			},
+			expectedOperation: "environment update",
+			expectedPolls:     2,
+			expectedStatus:    "Failed",
+		},
+		"application created": {
+			responses: []mockResponse{
+				{body: `{"applicationId":"app","status":"Created"}`},
+			},
+			expectedOperation: "application create",
+			expectedPolls:     1,
+			expectedStatus:    "Created",
+			expectedSucceeded: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+			conn, _ := newMockClient(t, testCase.responses...)
+
+			var err error
+			switch testCase.expectedOperation {
+			case "application create":
+				_, err = tfm2.WaitApplicationCreated(ctx, conn, "app", 30*time.Minute)
+			case "environment create":
+				_, err = tfm2.WaitEnvironmentCreated(ctx, conn, "env", 30*time.Minute)
+			case "environment update":
+				_, err = tfm2.WaitEnvironmentUpdated(ctx, conn, "env", 30*time.Minute)
+			}
+
+			if got, want := err == nil, testCase.expectedSucceeded; got != want {
+				t.Fatalf("succeeded = %t, want %t: %v", got, want, err)
+			}
+
+			entries, err := tflogtest.MultilineJSONDecode(&output)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var summary map[string]any
+			for _, entry := range entries {
+				if entry["@message"] == "Mainframe Modernization waiter summary" {
+					summary = entry
+				}
+			}
+
+			if summary == nil {
+				t.Fatalf("no waiter summary logged: %v", entries)
+			}
+
+			if got, want := summary["@level"], "info"; got != want {
+				t.Errorf("@level = %v, want %v", got, want)
+			}
+
+			if got, want := summary["operation"], testCase.expectedOperation; got != want {
+				t.Errorf("operation = %v, want %v", got, want)
+			}
+
+			if got, want := summary["polls"], testCase.expectedPolls; got != want {
+				t.Errorf("polls = %v, want %v", got, want)
+			}
+
+			if got, want := summary["not_found_polls"], testCase.expectedNotFoundPolls; got != want {
+				t.Errorf("not_found_polls = %v, want %v", got, want)
+			}
+
+			if got, want := summary["final_status"], testCase.expectedStatus; got != want {
+				t.Errorf("final_status = %v, want %v", got, want)
+			}
+
+			if got, want := summary["succeeded"], testCase.expectedSucceeded; got != want {
+				t.Errorf("succeeded = %v, want %v", got, want)
+			}
+
+			if _, ok := summary["elapsed"]; !ok {
+				t.Error("elapsed not logged")
+			}
+		})
+	}
+}